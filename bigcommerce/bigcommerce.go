@@ -4,8 +4,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"reflect"
 	"strings"
+	"time"
 
 	"context"
 
@@ -13,75 +18,210 @@ import (
 )
 
 const (
-	userAgent  = "go-bigcommerce"
-	methodGET  = "GET"
-	methodPOST = "POST"
-	methodPUT  = "PUT"
+	userAgent    = "go-bigcommerce"
+	apiHost      = "https://api.bigcommerce.com"
+	methodGET    = "GET"
+	methodPOST   = "POST"
+	methodPUT    = "PUT"
+	methodDELETE = "DELETE"
+
+	apiVersion2 = "v2"
+	apiVersion3 = "v3"
 )
 
 // Client is a Bigcommerce client for making Bigcommerce API requests.
 type Client struct {
+	config     *ClientConfig
 	httpClient *http.Client
+	limiter    *rateLimiter
+	// RetryPolicy configures how failed requests are retried.
+	// Requests are not retried when it is nil.
+	RetryPolicy *RetryPolicy
 	// Bigcommerce API Services
+	Brands                 *BrandService
+	Categories             *CategoryService
+	Customers              *CustomerService
+	CustomerAddresses      *CustomerAddressService
+	CustomerGroups         *CustomerGroupService
 	Orders                 *OrderService
+	OrderCoupons           *OrderCouponService
+	OrderMessages          *OrderMessageService
+	OrderProducts          *OrderProductService
+	OrderShipments         *OrderShipmentService
 	OrderShippingAddresses *OrderShippingAddressService
 	OrderStatuses          *OrderStatusService
+	OrderTaxes             *OrderTaxService
 	Products               *ProductService
 	ProductCustomFields    *ProductCustomFieldService
+	ProductImages          *ProductImageService
+	ProductModifiers       *ProductModifierService
+	ProductOptions         *ProductOptionService
+	ProductVariants        *ProductVariantService
 }
 
 // ClientConfig is used to configure the api connection.
+// Legacy API accounts authenticate with Endpoint, UserName and Password.
+// OAuth API accounts authenticate with StoreHash, ClientID and AccessToken,
+// which takes precedence over basic auth when an AccessToken is configured.
 type ClientConfig struct {
-	Endpoint string `json:"endpoint,omitempty"`
-	UserName string `json:"userName,omitempty"`
-	Password string `json:"password,omitempty"`
+	Endpoint    string `json:"endpoint,omitempty"`
+	UserName    string `json:"userName,omitempty"`
+	Password    string `json:"password,omitempty"`
+	StoreHash   string `json:"storeHash,omitempty"`
+	ClientID    string `json:"clientId,omitempty"`
+	AccessToken string `json:"accessToken,omitempty"`
+}
+
+// usesOAuth returns true if the config holds OAuth credentials.
+func (c *ClientConfig) usesOAuth() bool {
+	return c.AccessToken != ""
+}
+
+// apiURL returns the base url of the API for the configured store.
+func (c *ClientConfig) apiURL() string {
+	if c.usesOAuth() {
+		return fmt.Sprintf("%v/stores/%v", apiHost, c.StoreHash)
+	}
+	return fmt.Sprintf("%v/api", c.Endpoint)
+}
+
+// authenticate sets the authentication headers of the given request.
+func (c *ClientConfig) authenticate(req *http.Request) {
+	if c.usesOAuth() {
+		req.Header.Add("X-Auth-Client", c.ClientID)
+		req.Header.Add("X-Auth-Token", c.AccessToken)
+		return
+	}
+	req.SetBasicAuth(c.UserName, c.Password)
 }
 
 // NewClient returns a new Client.
 func NewClient(httpClient *http.Client, config *ClientConfig) *Client {
-	return &Client{
-		Orders:                 newOrderService(config, httpClient),
-		OrderShippingAddresses: newOrderShippingAddressService(config, httpClient),
-		OrderStatuses:          newOrderStatusService(config, httpClient),
-		Products:               newProductService(config, httpClient),
-		ProductCustomFields:    newProductCustomFieldService(config, httpClient),
+	client := &Client{
+		config:     config,
+		httpClient: httpClient,
+		limiter:    newRateLimiter(),
 	}
+	client.Brands = newBrandService(client)
+	client.Categories = newCategoryService(client)
+	client.Customers = newCustomerService(client)
+	client.CustomerAddresses = newCustomerAddressService(client)
+	client.CustomerGroups = newCustomerGroupService(client)
+	client.Orders = newOrderService(client)
+	client.OrderCoupons = newOrderCouponService(client)
+	client.OrderMessages = newOrderMessageService(client)
+	client.OrderProducts = newOrderProductService(client)
+	client.OrderShipments = newOrderShipmentService(client)
+	client.OrderShippingAddresses = newOrderShippingAddressService(client)
+	client.OrderStatuses = newOrderStatusService(client)
+	client.OrderTaxes = newOrderTaxService(client)
+	client.Products = newProductService(client)
+	client.ProductCustomFields = newProductCustomFieldService(client)
+	client.ProductImages = newProductImageService(client)
+	client.ProductModifiers = newProductModifierService(client)
+	client.ProductOptions = newProductOptionService(client)
+	client.ProductVariants = newProductVariantService(client)
+	return client
 }
 
 // performGET creates a new context aware HTTP GET request and returns the response.
-func performGET(ctx context.Context, httpClient *http.Client, config *ClientConfig, path string, queryParams interface{}, successV, failureV interface{}) (*http.Response, error) {
-	return performRequest(ctx, httpClient, config, methodGET, path, queryParams, nil, successV, failureV)
+func performGET(ctx context.Context, client *Client, path string, queryParams interface{}, successV interface{}) (*Response, error) {
+	return performRequest(ctx, client, methodGET, apiVersion2, path, queryParams, nil, successV)
 }
 
 // performPOST creates a new context aware HTTP POST request and returns the response.
-func performPOST(ctx context.Context, httpClient *http.Client, config *ClientConfig, path string, queryParams interface{}, body interface{}, successV, failureV interface{}) (*http.Response, error) {
-	return performRequest(ctx, httpClient, config, methodPOST, path, queryParams, body, successV, failureV)
+func performPOST(ctx context.Context, client *Client, path string, queryParams interface{}, body interface{}, successV interface{}) (*Response, error) {
+	return performRequest(ctx, client, methodPOST, apiVersion2, path, queryParams, body, successV)
 }
 
 // performPUT creates a new context aware HTTP PUT request and returns the response.
-func performPUT(ctx context.Context, httpClient *http.Client, config *ClientConfig, path string, queryParams interface{}, body interface{}, successV, failureV interface{}) (*http.Response, error) {
-	return performRequest(ctx, httpClient, config, methodPUT, path, queryParams, body, successV, failureV)
+func performPUT(ctx context.Context, client *Client, path string, queryParams interface{}, body interface{}, successV interface{}) (*Response, error) {
+	return performRequest(ctx, client, methodPUT, apiVersion2, path, queryParams, body, successV)
+}
+
+// performDELETE creates a new context aware HTTP DELETE request and returns the response.
+func performDELETE(ctx context.Context, client *Client, path string, queryParams interface{}) (*Response, error) {
+	return performRequest(ctx, client, methodDELETE, apiVersion2, path, queryParams, nil, nil)
+}
+
+// performV3GET creates a new context aware HTTP GET request against the V3 API and returns the response.
+func performV3GET(ctx context.Context, client *Client, path string, queryParams interface{}, successV interface{}) (*Response, error) {
+	return performV3Request(ctx, client, methodGET, path, queryParams, nil, successV)
+}
+
+// performV3POST creates a new context aware HTTP POST request against the V3 API and returns the response.
+func performV3POST(ctx context.Context, client *Client, path string, queryParams interface{}, body interface{}, successV interface{}) (*Response, error) {
+	return performV3Request(ctx, client, methodPOST, path, queryParams, body, successV)
+}
+
+// performV3PUT creates a new context aware HTTP PUT request against the V3 API and returns the response.
+func performV3PUT(ctx context.Context, client *Client, path string, queryParams interface{}, body interface{}, successV interface{}) (*Response, error) {
+	return performV3Request(ctx, client, methodPUT, path, queryParams, body, successV)
+}
+
+// performV3DELETE creates a new context aware HTTP DELETE request against the V3 API and returns the response.
+func performV3DELETE(ctx context.Context, client *Client, path string, queryParams interface{}) (*Response, error) {
+	return performRequest(ctx, client, methodDELETE, apiVersion3, path, queryParams, nil, nil)
+}
+
+// performV3Request creates a new context aware HTTP request against the V3 API and returns the response.
+// The data of the response envelope is decoded into successV.
+func performV3Request(ctx context.Context, client *Client, method string, path string, queryParams interface{}, body interface{}, successV interface{}) (*Response, error) {
+	envelope := &V3Response{Data: successV}
+	return performRequest(ctx, client, method, apiVersion3, path, queryParams, body, envelope)
 }
 
 // performRequest creates a new context aware HTTP request and returns the response.
-func performRequest(ctx context.Context, httpClient *http.Client, config *ClientConfig, method string, path string, queryParams interface{}, body interface{}, successV, failureV interface{}) (*http.Response, error) {
-	// Marshal payload
-	payload, err := json.Marshal(body)
+func performRequest(ctx context.Context, client *Client, method string, apiVersion string, path string, queryParams interface{}, body interface{}, successV interface{}) (*Response, error) {
+	req, err := client.NewRequest(ctx, method, fmt.Sprintf("%v/%v", apiVersion, path), queryParams, body)
 	if err != nil {
 		return nil, err
 	}
+	return client.Do(req, successV)
+}
+
+// BodyEncoder is implemented by request bodies that are not JSON encoded,
+// such as multipart file uploads. EncodeBody returns the Content-Type and the
+// encoded body, which is replayed when a request is retried.
+type BodyEncoder interface {
+	EncodeBody() (contentType string, data []byte, err error)
+}
+
+// NewRequest creates a new context aware HTTP request for the API of the
+// configured store. The path includes the API version, e.g. "v2/orders" or
+// "v3/catalog/products". The queryParams are encoded with go-querystring and
+// the body is encoded by its BodyEncoder or JSON encoded unless nil.
+func (c *Client) NewRequest(ctx context.Context, method string, path string, queryParams interface{}, body interface{}) (*http.Request, error) {
+	// Marshal payload
+	var payload io.Reader
+	contentType := "application/json"
+	if encoder, ok := body.(BodyEncoder); ok {
+		var data []byte
+		var err error
+		contentType, data, err = encoder.EncodeBody()
+		if err != nil {
+			return nil, err
+		}
+		payload = bytes.NewReader(data)
+	} else if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		payload = bytes.NewReader(data)
+	}
 	// Generate Request Url with query params
-	queryValues, err := goquery.Values(queryParams)
+	queryValues, err := encodeQuery(queryParams)
 	if err != nil {
 		return nil, err
 	}
 	queryString := queryValues.Encode()
-	url := fmt.Sprintf("%v/api/v2/%v", config.Endpoint, path)
+	url := fmt.Sprintf("%v/%v", c.config.apiURL(), strings.TrimPrefix(path, "/"))
 	if queryString != "" {
 		url = strings.Join([]string{url, queryString}, "?")
 	}
 	// Create Request
-	req, err := http.NewRequest(method, url, bytes.NewBuffer(payload))
+	req, err := http.NewRequest(method, url, payload)
 	if err != nil {
 		return nil, err
 	}
@@ -89,46 +229,131 @@ func performRequest(ctx context.Context, httpClient *http.Client, config *Client
 
 	// Set Headers
 	req.Header.Add("Accept", "application/json; charset=utf-8")
-	req.Header.Add("Content-Type", "application/json")
+	if body != nil {
+		req.Header.Add("Content-Type", contentType)
+	}
 	req.Header.Add("User-Agent", userAgent)
-	req.SetBasicAuth(config.UserName, config.Password)
-	// Perform request
-	response, err := httpClient.Do(req)
+	c.config.authenticate(req)
+	return req, nil
+}
+
+// encodeQuery encodes the given query params with go-querystring. Non-zero
+// time.Time fields are encoded in RFC 2822 format as expected by the API,
+// which go-querystring encodes in RFC 3339 format by default.
+func encodeQuery(queryParams interface{}) (url.Values, error) {
+	values, err := goquery.Values(queryParams)
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.ValueOf(queryParams)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return values, nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return values, nil
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.PkgPath != "" || field.Type != reflect.TypeOf(time.Time{}) {
+			continue
+		}
+		t := v.Field(i).Interface().(time.Time)
+		name := strings.Split(field.Tag.Get("url"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		values.Del(name)
+		if !t.IsZero() {
+			values.Set(name, t.Format(time.RFC1123Z))
+		}
+	}
+	return values, nil
+}
+
+// Do sends the request and decodes the JSON response into the value pointed
+// to by v, unless v is nil. V3 responses are wrapped in a data/meta envelope,
+// which is decoded by passing a *V3Response with Data set to the target value.
+// Requests failing with a non 2XX status return an *Error.
+func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+	httpResponse, err := sendRequest(c, req)
 	if err != nil {
 		return nil, err
 	}
 	// when err is nil, resp contains a non-nil resp.Body which must be closed
-	defer response.Body.Close()
-	if successV != nil || failureV != nil {
-		err = decodeResponseJSON(response, successV, failureV)
+	defer httpResponse.Body.Close()
+	err = decodeResponseJSON(httpResponse, v)
+	response := newResponse(httpResponse)
+	if envelope, ok := v.(*V3Response); ok {
+		response.Pagination = envelope.Meta.Pagination
 	}
 	return response, err
 }
 
-// decodeResponse decodes response Body into the value pointed to by successV
-// if the response is a success (2XX) or into the value pointed to by failureV
-// otherwise. If the successV or failureV argument to decode into is nil,
-// decoding is skipped.
+// sendRequest sends the request and retries it according to the RetryPolicy
+// of the client. The request body is replayed on every attempt. Every attempt
+// waits for the rate limiter of the client before it is sent.
+func sendRequest(client *Client, req *http.Request) (*http.Response, error) {
+	policy := client.RetryPolicy
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		if err := client.limiter.wait(req.Context()); err != nil {
+			return nil, err
+		}
+		response, err := client.httpClient.Do(req)
+		if err == nil {
+			client.limiter.update(response.Header)
+		}
+		if !policy.shouldRetry(req, response, err, attempt) {
+			return response, err
+		}
+		wait := policy.backoff(response, attempt)
+		if response != nil {
+			io.Copy(ioutil.Discard, response.Body)
+			response.Body.Close()
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// decodeResponseJSON decodes response Body into the value pointed to by successV
+// if the response is a success (2XX). If successV is nil, decoding is skipped.
+// Otherwise, an *Error describing the failed request is returned.
 // Caller is responsible for closing the resp.Body.
-func decodeResponseJSON(resp *http.Response, successV, failureV interface{}) error {
+func decodeResponseJSON(resp *http.Response, successV interface{}) error {
 	if code := resp.StatusCode; 200 <= code && code <= 299 {
 		if successV != nil {
 			return decodeResponseBodyJSON(resp, successV)
 		}
-	} else {
-		if failureV != nil {
-			if strings.Contains(resp.Header.Get("Content-Type"), "application/json") {
-				return decodeResponseBodyJSON(resp, failureV)
-			}
-			return fmt.Errorf("bigcommerce: %v", resp.Status)
-		}
+		return nil
 	}
-	return nil
+	return newError(resp)
 }
 
 // decodeResponseBodyJSON JSON decodes a Response Body into the value pointed
-// to by v.
+// to by v. An empty Body, as sent with 204 No Content, leaves v untouched.
 // Caller must provide a non-nil v and close the resp.Body.
 func decodeResponseBodyJSON(resp *http.Response, v interface{}) error {
-	return json.NewDecoder(resp.Body).Decode(v)
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	err := json.NewDecoder(resp.Body).Decode(v)
+	if err == io.EOF {
+		return nil
+	}
+	return err
 }
@@ -1,6 +1,9 @@
 package bigcommerce
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -13,6 +16,7 @@ import (
 var defaultTestTimeout = time.Second * 1
 
 const BadRequestJSON = `[{ "status": 400, "message": "Bad Request" }]`
+const BadRequestV3JSON = `{ "status": 400, "title": "Bad Request" }`
 const BadRequestErrorMessage = "bigcommerce: 400 Bad Request"
 
 // testServer returns an http Client, ServeMux, and Server. The client proxies
@@ -94,3 +98,259 @@ func assertClosed(t *testing.T, ch <-chan interface{}, timeout time.Duration) {
 		t.Errorf("expected channel to be closed within timeout %v", timeout)
 	}
 }
+
+func TestClient_BasicAuth(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/order_statuses/", func(w http.ResponseWriter, r *http.Request) {
+		userName, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "go-bigcommerce", userName)
+		assert.Equal(t, "12345", password)
+		assert.Equal(t, "", r.Header.Get("X-Auth-Token"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 1 }]`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, _, err := client.OrderStatuses.List(context.Background(), &OrderStatusListParams{})
+	assert.Nil(t, err)
+}
+
+func TestClient_OAuth(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v2/order_statuses/", func(w http.ResponseWriter, r *http.Request) {
+		_, _, ok := r.BasicAuth()
+		assert.False(t, ok)
+		assert.Equal(t, "api.bigcommerce.com", r.Host)
+		assert.Equal(t, "client-id", r.Header.Get("X-Auth-Client"))
+		assert.Equal(t, "access-token", r.Header.Get("X-Auth-Token"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 1 }]`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	_, _, err := client.OrderStatuses.List(context.Background(), &OrderStatusListParams{})
+	assert.Nil(t, err)
+}
+
+func TestPerformV3GET(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "2"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "data": [{ "id": 123 }],
+  "meta": {
+    "pagination": {
+      "total": 3,
+      "count": 1,
+      "per_page": 1,
+      "current_page": 2,
+      "total_pages": 3,
+      "links": {
+        "previous": "?page=1&limit=1",
+        "current": "?page=2&limit=1",
+        "next": "?page=3&limit=1"
+      }
+    }
+  }
+}`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := struct {
+		Page int `url:"page,omitempty"`
+	}{Page: 2}
+	var products []Product
+	response, err := performV3GET(context.Background(), client, "catalog/products", params, &products)
+	assert.Nil(t, err)
+	assert.Equal(t, []Product{{ID: 123}}, products)
+	assert.Equal(t, &Pagination{
+		Total:       3,
+		Count:       1,
+		PerPage:     1,
+		CurrentPage: 2,
+		TotalPages:  3,
+		Links: PaginationLinks{
+			Previous: "?page=1&limit=1",
+			Current:  "?page=2&limit=1",
+			Next:     "?page=3&limit=1",
+		},
+	}, response.Pagination)
+}
+
+func TestPerformV3GETWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/123", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{ "status": 404, "title": "Product not found", "type": "https://developer.bigcommerce.com/api-docs/getting-started/api-status-codes" }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	product := new(Product)
+	response, err := performV3GET(context.Background(), client, "catalog/products/123", nil, product)
+	assert.EqualError(t, err, "bigcommerce: 404 Product not found")
+	assert.Nil(t, response.Pagination)
+}
+
+func TestClient_NewRequest(t *testing.T) {
+	client := NewClient(http.DefaultClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := struct {
+		Page int `url:"page,omitempty"`
+	}{Page: 2}
+	body := map[string]string{"name": "Shoes"}
+	req, err := client.NewRequest(context.Background(), "PUT", "v3/catalog/brands/12", params, body)
+	assert.Nil(t, err)
+	assert.Equal(t, "PUT", req.Method)
+	assert.Equal(t, "https://api.bigcommerce.com/stores/abc123/v3/catalog/brands/12?page=2", req.URL.String())
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	assert.Equal(t, "access-token", req.Header.Get("X-Auth-Token"))
+	payload, err := ioutil.ReadAll(req.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"Shoes"}`, string(payload))
+}
+
+func TestEncodeQuery(t *testing.T) {
+	minDate := time.Date(2012, time.November, 14, 19, 26, 23, 0, time.UTC)
+	maxDate := time.Date(2012, time.November, 15, 8, 0, 0, 0, time.FixedZone("CET", 3600))
+	params := &struct {
+		Page    int       `url:"page,omitempty"`
+		MinDate time.Time `url:"min_date,omitempty"`
+		MaxDate time.Time `url:"max_date"`
+		Unset   time.Time `url:"unset"`
+		Skipped time.Time `url:"-"`
+		hidden  time.Time
+	}{
+		Page:    2,
+		MinDate: minDate,
+		MaxDate: maxDate,
+		Skipped: minDate,
+		hidden:  minDate,
+	}
+	values, err := encodeQuery(params)
+	assert.Nil(t, err)
+	assert.Equal(t, url.Values{
+		"page":     {"2"},
+		"min_date": {"Wed, 14 Nov 2012 19:26:23 +0000"},
+		"max_date": {"Thu, 15 Nov 2012 08:00:00 +0100"},
+	}, values)
+	assert.Equal(t, "max_date=Thu%2C+15+Nov+2012+08%3A00%3A00+%2B0100&min_date=Wed%2C+14+Nov+2012+19%3A26%3A23+%2B0000&page=2", values.Encode())
+
+	values, err = encodeQuery((*OrderListParams)(nil))
+	assert.Nil(t, err)
+	assert.Empty(t, values)
+}
+
+type csvBody string
+
+func (b csvBody) EncodeBody() (string, []byte, error) {
+	return "text/csv", []byte(b), nil
+}
+
+func TestClient_NewRequestBodyEncoder(t *testing.T) {
+	client := NewClient(http.DefaultClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	req, err := client.NewRequest(context.Background(), "POST", "v2/imports", nil, csvBody("id,name\n1,Shoes"))
+	assert.Nil(t, err)
+	assert.Equal(t, "text/csv", req.Header.Get("Content-Type"))
+	payload, err := ioutil.ReadAll(req.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, "id,name\n1,Shoes", string(payload))
+	assert.NotNil(t, req.GetBody)
+}
+
+func TestClient_Do(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/time", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "time": 1355934660 }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	req, err := client.NewRequest(context.Background(), "GET", "v2/time", nil, nil)
+	assert.Nil(t, err)
+	var v struct {
+		Time int `json:"time"`
+	}
+	_, err = client.Do(req, &v)
+	assert.Nil(t, err)
+	assert.Equal(t, 1355934660, v.Time)
+}
+
+func TestClient_DoV3(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/summary", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "inventory_count": 42 }, "meta": {} }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	req, err := client.NewRequest(context.Background(), "GET", "v3/catalog/summary", nil, nil)
+	assert.Nil(t, err)
+	var summary struct {
+		InventoryCount int `json:"inventory_count"`
+	}
+	_, err = client.Do(req, &V3Response{Data: &summary})
+	assert.Nil(t, err)
+	assert.Equal(t, 42, summary.InventoryCount)
+}
+
+func TestClient_DoWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/time", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	req, err := client.NewRequest(context.Background(), "GET", "v2/time", nil, nil)
+	assert.Nil(t, err)
+	_, err = client.Do(req, nil)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
@@ -0,0 +1,203 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"context"
+)
+
+const brandServicePath = "brands/"
+
+// Brand describes the brand resource
+type Brand struct {
+	ID              int      `json:"id"`
+	Name            string   `json:"name"`
+	PageTitle       string   `json:"page_title"`
+	MetaKeywords    []string `json:"meta_keywords"`
+	MetaDescription string   `json:"meta_description"`
+	ImageFile       string   `json:"image_file"`
+	SearchKeywords  string   `json:"search_keywords"`
+}
+
+// BrandService adds the APIs for the Brand resource.
+type BrandService struct {
+	client *Client
+
+	// mu guards ids, which caches the brand IDs resolved by name.
+	mu  sync.Mutex
+	ids map[string]int
+}
+
+func newBrandService(client *Client) *BrandService {
+	return &BrandService{
+		client: client,
+		ids:    make(map[string]int),
+	}
+}
+
+// BrandListParams are the parameters for BrandService.List
+type BrandListParams struct {
+	Page  int    `url:"page,omitempty"`
+	Limit int    `url:"limit,omitempty"`
+	MinID int    `url:"min_id,omitempty"`
+	MaxID int    `url:"max_id,omitempty"`
+	Name  string `url:"name,omitempty"`
+}
+
+// List returns a list of Brands matching the given BrandListParams.
+func (s *BrandService) List(ctx context.Context, params *BrandListParams) ([]Brand, *Response, error) {
+	var brands []Brand
+
+	response, err := performGET(ctx, s.client, brandServicePath, params, &brands)
+
+	return brands, response, err
+}
+
+// BrandIterator iterates over the Brands of all pages matching the given BrandListParams.
+type BrandIterator struct {
+	iterator
+	brands []Brand
+}
+
+// Next advances the iterator to the next Brand. It returns false once all pages are consumed or an error occurred.
+func (it *BrandIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current Brand. It is only valid after Next returned true.
+func (it *BrandIterator) Value() Brand {
+	return it.brands[it.index]
+}
+
+// ListAll returns a BrandIterator over the Brands of all pages matching the given BrandListParams.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *BrandService) ListAll(ctx context.Context, params *BrandListParams) *BrandIterator {
+	var p BrandListParams
+	if params != nil {
+		p = *params
+	}
+	it := &BrandIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		brands, _, err := s.List(ctx, &p)
+		it.brands = brands
+		return len(brands), err
+	})
+	return it
+}
+
+// Count returns the number of Brands matching the given BrandListParams.
+func (s *BrandService) Count(ctx context.Context, params *BrandListParams) (int, *Response, error) {
+	var cnt count
+
+	path := strings.Join([]string{brandServicePath, "count"}, "")
+	response, err := performGET(ctx, s.client, path, params, &cnt)
+
+	return cnt.Count, response, err
+}
+
+// Show returns the requested Brand.
+func (s *BrandService) Show(ctx context.Context, id int) (*Brand, *Response, error) {
+	brand := new(Brand)
+
+	path := fmt.Sprintf("%v%v", brandServicePath, id)
+	response, err := performGET(ctx, s.client, path, nil, brand)
+
+	return brand, response, err
+}
+
+// BrandBody describes the brand information given when creating or editing a Brand.
+// Name is required when creating a brand. Unset fields are left untouched when editing.
+type BrandBody struct {
+	Name            string   `json:"name,omitempty"`
+	PageTitle       string   `json:"page_title,omitempty"`
+	MetaKeywords    []string `json:"meta_keywords,omitempty"`
+	MetaDescription string   `json:"meta_description,omitempty"`
+	ImageFile       string   `json:"image_file,omitempty"`
+	SearchKeywords  string   `json:"search_keywords,omitempty"`
+}
+
+// New creates a new Brand with the specified information and returns the new brand.
+func (s *BrandService) New(ctx context.Context, body *BrandBody) (*Brand, *Response, error) {
+	brand := new(Brand)
+
+	response, err := performPOST(ctx, s.client, brandServicePath, nil, body, brand)
+	if err == nil {
+		s.cache(brand)
+	}
+
+	return brand, response, err
+}
+
+// Edit updates the given Brand with the given BrandBody.
+func (s *BrandService) Edit(ctx context.Context, id int, body *BrandBody) (*Brand, *Response, error) {
+	brand := new(Brand)
+
+	path := fmt.Sprintf("%v%v", brandServicePath, id)
+	response, err := performPUT(ctx, s.client, path, nil, body, brand)
+	if err == nil {
+		s.uncache(id)
+		s.cache(brand)
+	}
+
+	return brand, response, err
+}
+
+// Delete deletes the given Brand.
+func (s *BrandService) Delete(ctx context.Context, id int) (*Response, error) {
+	path := fmt.Sprintf("%v%v", brandServicePath, id)
+	response, err := performDELETE(ctx, s.client, path, nil)
+	if err == nil {
+		s.uncache(id)
+	}
+	return response, err
+}
+
+// ResolveOrCreate returns the ID of the Brand with the given name and creates the brand if it does not exist.
+// Resolved IDs are cached for the lifetime of the client, so brands created, renamed or deleted by other
+// means than this client may be missed. Concurrent calls are serialized to avoid creating duplicate brands.
+func (s *BrandService) ResolveOrCreate(ctx context.Context, name string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if id, ok := s.ids[name]; ok {
+		return id, nil
+	}
+	brands, _, err := s.List(ctx, &BrandListParams{Name: name})
+	if err != nil {
+		return 0, err
+	}
+	for _, brand := range brands {
+		if brand.Name == name {
+			s.ids[name] = brand.ID
+			return brand.ID, nil
+		}
+	}
+	brand := new(Brand)
+	if _, err := performPOST(ctx, s.client, brandServicePath, nil, &BrandBody{Name: name}, brand); err != nil {
+		return 0, err
+	}
+	s.ids[name] = brand.ID
+	return brand.ID, nil
+}
+
+// cache stores the ID of the given Brand by name.
+func (s *BrandService) cache(brand *Brand) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if brand.Name != "" {
+		s.ids[brand.Name] = brand.ID
+	}
+}
+
+// uncache removes the given Brand ID from the cache.
+func (s *BrandService) uncache(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for name, cached := range s.ids {
+		if cached == id {
+			delete(s.ids, name)
+		}
+	}
+}
@@ -0,0 +1,405 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBrandService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 123 }]`)
+	})
+
+	expected := []Brand{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &BrandListParams{
+		Page: 1,
+	}
+	brands, _, err := client.Brands.List(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, brands)
+}
+
+func TestBrandService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &BrandListParams{
+		Page: 1,
+	}
+	brands, _, err := client.Brands.List(context.Background(), params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(brands) == 0)
+}
+
+func TestBrandService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var brands []Brand
+	it := client.Brands.ListAll(context.Background(), nil)
+	for it.Next() {
+		brands = append(brands, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []Brand{{ID: 1}, {ID: 2}, {ID: 3}}, brands)
+}
+
+func TestBrandService_Count(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "count": 12 }`)
+	})
+
+	expected := 12
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &BrandListParams{
+		Limit: 10,
+	}
+	count, _, err := client.Brands.Count(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, count)
+}
+
+func TestBrandService_CountWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &BrandListParams{
+		Limit: 10,
+	}
+	_, _, err := client.Brands.Count(context.Background(), params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestBrandService_Show(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &Brand{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	brand, _, err := client.Brands.Show(context.Background(), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, brand)
+}
+
+func TestBrandService_ShowWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, _, err := client.Brands.Show(context.Background(), 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestBrandService_New(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &Brand{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &BrandBody{
+		Name: "ACME",
+	}
+	brand, _, err := client.Brands.New(context.Background(), body)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, brand)
+}
+
+func TestBrandService_NewWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &BrandBody{
+		Name: "ACME",
+	}
+	_, _, err := client.Brands.New(context.Background(), body)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestBrandService_Edit(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &Brand{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &BrandBody{
+		Name: "ACME Inc.",
+	}
+	brand, _, err := client.Brands.Edit(context.Background(), 3, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, brand)
+}
+
+func TestBrandService_EditWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &BrandBody{
+		Name: "ACME Inc.",
+	}
+	_, _, err := client.Brands.Edit(context.Background(), 3, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestBrandService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	response, err := client.Brands.Delete(context.Background(), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestBrandService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, err := client.Brands.Delete(context.Background(), 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestBrandService_ResolveOrCreateExisting(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	requests := 0
+	mux.HandleFunc("/api/v2/brands/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"name": "ACME"}, r)
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 7, "name": "ACME" }]`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	for i := 0; i < 2; i++ {
+		id, err := client.Brands.ResolveOrCreate(context.Background(), "ACME")
+		assert.Nil(t, err)
+		assert.Equal(t, 7, id)
+	}
+	assert.Equal(t, 1, requests)
+}
+
+func TestBrandService_ResolveOrCreateMissing(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusNoContent)
+		case "POST":
+			body, err := ioutil.ReadAll(r.Body)
+			assert.Nil(t, err)
+			assert.JSONEq(t, `{ "name": "ACME" }`, string(body))
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{ "id": 8, "name": "ACME" }`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	id, err := client.Brands.ResolveOrCreate(context.Background(), "ACME")
+	assert.Nil(t, err)
+	assert.Equal(t, 8, id)
+}
+
+func TestBrandService_ResolveOrCreateWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/brands/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, err := client.Brands.ResolveOrCreate(context.Background(), "ACME")
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestBrandService_ResolveOrCreateAfterDelete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	requests := 0
+	mux.HandleFunc("/api/v2/brands/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 7, "name": "ACME" }]`)
+	})
+	mux.HandleFunc("/api/v2/brands/7", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, err := client.Brands.ResolveOrCreate(context.Background(), "ACME")
+	assert.Nil(t, err)
+	_, err = client.Brands.Delete(context.Background(), 7)
+	assert.Nil(t, err)
+	_, err = client.Brands.ResolveOrCreate(context.Background(), "ACME")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, requests)
+}
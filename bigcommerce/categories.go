@@ -0,0 +1,167 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"strings"
+
+	"context"
+)
+
+const categoryServicePath = "categories/"
+
+// Category describes the category resource
+type Category struct {
+	ID                 int      `json:"id"`
+	ParentID           int      `json:"parent_id"`
+	Name               string   `json:"name"`
+	Description        string   `json:"description"`
+	SortOrder          int      `json:"sort_order"`
+	PageTitle          string   `json:"page_title"`
+	MetaKeywords       []string `json:"meta_keywords"`
+	MetaDescription    string   `json:"meta_description"`
+	LayoutFile         string   `json:"layout_file"`
+	ParentCategoryList []int    `json:"parent_category_list"`
+	ImageFile          string   `json:"image_file"`
+	IsVisible          bool     `json:"is_visible"`
+	SearchKeywords     string   `json:"search_keywords"`
+	URL                string   `json:"url"`
+}
+
+// CategoryService adds the APIs for the Category resource.
+type CategoryService struct {
+	client *Client
+}
+
+func newCategoryService(client *Client) *CategoryService {
+	return &CategoryService{
+		client: client,
+	}
+}
+
+// CategoryListParams are the parameters for CategoryService.List
+type CategoryListParams struct {
+	Page      int    `url:"page,omitempty"`
+	Limit     int    `url:"limit,omitempty"`
+	MinID     int    `url:"min_id,omitempty"`
+	MaxID     int    `url:"max_id,omitempty"`
+	Name      string `url:"name,omitempty"`
+	ParentID  *int   `url:"parent_id,omitempty"`
+	IsVisible *bool  `url:"is_visible,omitempty"`
+}
+
+// List returns a list of Categories matching the given CategoryListParams.
+func (s *CategoryService) List(ctx context.Context, params *CategoryListParams) ([]Category, *Response, error) {
+	var categories []Category
+
+	response, err := performGET(ctx, s.client, categoryServicePath, params, &categories)
+
+	return categories, response, err
+}
+
+// CategoryIterator iterates over the Categories of all pages matching the given CategoryListParams.
+type CategoryIterator struct {
+	iterator
+	categories []Category
+}
+
+// Next advances the iterator to the next Category. It returns false once all pages are consumed or an error occurred.
+func (it *CategoryIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current Category. It is only valid after Next returned true.
+func (it *CategoryIterator) Value() Category {
+	return it.categories[it.index]
+}
+
+// ListAll returns a CategoryIterator over the Categories of all pages matching the given CategoryListParams.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *CategoryService) ListAll(ctx context.Context, params *CategoryListParams) *CategoryIterator {
+	var p CategoryListParams
+	if params != nil {
+		p = *params
+	}
+	it := &CategoryIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		categories, _, err := s.List(ctx, &p)
+		it.categories = categories
+		return len(categories), err
+	})
+	return it
+}
+
+// Count returns the number of Categories matching the given CategoryListParams.
+func (s *CategoryService) Count(ctx context.Context, params *CategoryListParams) (int, *Response, error) {
+	var cnt count
+
+	path := strings.Join([]string{categoryServicePath, "count"}, "")
+	response, err := performGET(ctx, s.client, path, params, &cnt)
+
+	return cnt.Count, response, err
+}
+
+// Show returns the requested Category.
+func (s *CategoryService) Show(ctx context.Context, id int) (*Category, *Response, error) {
+	category := new(Category)
+
+	path := fmt.Sprintf("%v%v", categoryServicePath, id)
+	response, err := performGET(ctx, s.client, path, nil, category)
+
+	return category, response, err
+}
+
+// CategoryBody describes the category information given when creating or editing a Category.
+// Name is required when creating a category. Unset fields are left untouched when editing.
+type CategoryBody struct {
+	ParentID        *int     `json:"parent_id,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	Description     string   `json:"description,omitempty"`
+	SortOrder       *int     `json:"sort_order,omitempty"`
+	PageTitle       string   `json:"page_title,omitempty"`
+	MetaKeywords    []string `json:"meta_keywords,omitempty"`
+	MetaDescription string   `json:"meta_description,omitempty"`
+	LayoutFile      string   `json:"layout_file,omitempty"`
+	ImageFile       string   `json:"image_file,omitempty"`
+	IsVisible       *bool    `json:"is_visible,omitempty"`
+	SearchKeywords  string   `json:"search_keywords,omitempty"`
+	URL             string   `json:"url,omitempty"`
+}
+
+// New creates a new Category with the specified information and returns the new category.
+func (s *CategoryService) New(ctx context.Context, body *CategoryBody) (*Category, *Response, error) {
+	category := new(Category)
+
+	response, err := performPOST(ctx, s.client, categoryServicePath, nil, body, category)
+
+	return category, response, err
+}
+
+// Edit updates the given Category with the given CategoryBody.
+func (s *CategoryService) Edit(ctx context.Context, id int, body *CategoryBody) (*Category, *Response, error) {
+	category := new(Category)
+
+	path := fmt.Sprintf("%v%v", categoryServicePath, id)
+	response, err := performPUT(ctx, s.client, path, nil, body, category)
+
+	return category, response, err
+}
+
+// Delete deletes the given Category.
+func (s *CategoryService) Delete(ctx context.Context, id int) (*Response, error) {
+	path := fmt.Sprintf("%v%v", categoryServicePath, id)
+	return performDELETE(ctx, s.client, path, nil)
+}
+
+// Tree fetches all Categories and returns them as CategoryTree.
+func (s *CategoryService) Tree(ctx context.Context) (*CategoryTree, error) {
+	var categories []Category
+	it := s.ListAll(ctx, nil)
+	for it.Next() {
+		categories = append(categories, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return NewCategoryTree(categories), nil
+}
@@ -0,0 +1,348 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 123 }]`)
+	})
+
+	expected := []Category{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CategoryListParams{
+		Page: 1,
+	}
+	categories, _, err := client.Categories.List(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, categories)
+}
+
+func TestCategoryService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CategoryListParams{
+		Page: 1,
+	}
+	categories, _, err := client.Categories.List(context.Background(), params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(categories) == 0)
+}
+
+func TestCategoryService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var categories []Category
+	it := client.Categories.ListAll(context.Background(), nil)
+	for it.Next() {
+		categories = append(categories, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []Category{{ID: 1}, {ID: 2}, {ID: 3}}, categories)
+}
+
+func TestCategoryService_Count(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "count": 12 }`)
+	})
+
+	expected := 12
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CategoryListParams{
+		Limit: 10,
+	}
+	count, _, err := client.Categories.Count(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, count)
+}
+
+func TestCategoryService_CountWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CategoryListParams{
+		Limit: 10,
+	}
+	_, _, err := client.Categories.Count(context.Background(), params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCategoryService_Show(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &Category{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	category, _, err := client.Categories.Show(context.Background(), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, category)
+}
+
+func TestCategoryService_ShowWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, _, err := client.Categories.Show(context.Background(), 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCategoryService_New(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &Category{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &CategoryBody{
+		Name: "Shoes",
+	}
+	category, _, err := client.Categories.New(context.Background(), body)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, category)
+}
+
+func TestCategoryService_NewWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &CategoryBody{
+		Name: "Shoes",
+	}
+	_, _, err := client.Categories.New(context.Background(), body)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCategoryService_Edit(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &Category{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CategoryBody{
+		Name: "Sneakers",
+	}
+	category, _, err := client.Categories.Edit(context.Background(), 3, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, category)
+}
+
+func TestCategoryService_EditWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CategoryBody{
+		Name: "Sneakers",
+	}
+	_, _, err := client.Categories.Edit(context.Background(), 3, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCategoryService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	response, err := client.Categories.Delete(context.Background(), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestCategoryService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, err := client.Categories.Delete(context.Background(), 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCategoryService_Tree(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1, "parent_id": 0, "name": "Apparel" }, { "id": 2, "parent_id": 1, "name": "Shoes" }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	tree, err := client.Categories.Tree(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, tree.Find("Apparel/Shoes").ID)
+}
+
+func TestCategoryService_TreeWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/categories/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	tree, err := client.Categories.Tree(context.Background())
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.Nil(t, tree)
+}
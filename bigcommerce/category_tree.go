@@ -0,0 +1,112 @@
+package bigcommerce
+
+import (
+	"sort"
+	"strings"
+)
+
+// CategoryPathSeparator separates the category names of a path, e.g. "Apparel/Shoes/Running".
+const CategoryPathSeparator = "/"
+
+// CategoryNode is a Category within a CategoryTree.
+type CategoryNode struct {
+	Category
+	Parent   *CategoryNode
+	Children []*CategoryNode
+}
+
+// Path returns the names of the node and its ancestors joined by CategoryPathSeparator.
+func (n *CategoryNode) Path() string {
+	var names []string
+	for node := n; node != nil; node = node.Parent {
+		names = append([]string{node.Name}, names...)
+	}
+	return strings.Join(names, CategoryPathSeparator)
+}
+
+// Child returns the child with the given name or nil if there is none.
+func (n *CategoryNode) Child(name string) *CategoryNode {
+	return findCategoryNode(n.Children, name)
+}
+
+// descendsFrom returns true if the node is the given ancestor or one of its descendants.
+func (n *CategoryNode) descendsFrom(ancestor *CategoryNode) bool {
+	for node := n; node != nil; node = node.Parent {
+		if node == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+// CategoryTree is an in-memory tree of Categories linked by their parent_id.
+type CategoryTree struct {
+	// Roots are the top level categories. Categories whose parent is unknown or
+	// whose parent_id creates a cycle are treated as top level categories.
+	Roots []*CategoryNode
+	nodes map[int]*CategoryNode
+}
+
+// NewCategoryTree assembles the given flat list of Categories into a CategoryTree.
+// Siblings are ordered by sort_order and name. A category whose parent_id would
+// create a cycle is treated as a top level category.
+func NewCategoryTree(categories []Category) *CategoryTree {
+	tree := &CategoryTree{
+		nodes: make(map[int]*CategoryNode, len(categories)),
+	}
+	for _, category := range categories {
+		tree.nodes[category.ID] = &CategoryNode{Category: category}
+	}
+	for _, category := range categories {
+		node := tree.nodes[category.ID]
+		parent, ok := tree.nodes[category.ParentID]
+		if !ok || parent.descendsFrom(node) {
+			tree.Roots = append(tree.Roots, node)
+			continue
+		}
+		node.Parent = parent
+		parent.Children = append(parent.Children, node)
+	}
+	sortCategoryNodes(tree.Roots)
+	for _, node := range tree.nodes {
+		sortCategoryNodes(node.Children)
+	}
+	return tree
+}
+
+// Node returns the node of the Category with the given ID or nil if there is none.
+func (t *CategoryTree) Node(id int) *CategoryNode {
+	return t.nodes[id]
+}
+
+// Find returns the node at the given path of category names, e.g. "Apparel/Shoes/Running",
+// or nil if there is none. Names are matched exactly. If siblings share a name, the first is used.
+func (t *CategoryTree) Find(path string) *CategoryNode {
+	names := strings.Split(strings.Trim(path, CategoryPathSeparator), CategoryPathSeparator)
+	node := findCategoryNode(t.Roots, names[0])
+	for _, name := range names[1:] {
+		if node == nil {
+			return nil
+		}
+		node = node.Child(name)
+	}
+	return node
+}
+
+func findCategoryNode(nodes []*CategoryNode, name string) *CategoryNode {
+	for _, node := range nodes {
+		if node.Name == name {
+			return node
+		}
+	}
+	return nil
+}
+
+func sortCategoryNodes(nodes []*CategoryNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].SortOrder != nodes[j].SortOrder {
+			return nodes[i].SortOrder < nodes[j].SortOrder
+		}
+		return nodes[i].Name < nodes[j].Name
+	})
+}
@@ -0,0 +1,67 @@
+package bigcommerce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testCategoryTree() *CategoryTree {
+	return NewCategoryTree([]Category{
+		{ID: 4, ParentID: 2, Name: "Running"},
+		{ID: 1, ParentID: 0, Name: "Apparel", SortOrder: 1},
+		{ID: 2, ParentID: 1, Name: "Shoes", SortOrder: 2},
+		{ID: 3, ParentID: 1, Name: "Shirts", SortOrder: 1},
+		{ID: 5, ParentID: 0, Name: "Accessories", SortOrder: 1},
+		{ID: 6, ParentID: 99, Name: "Orphan"},
+		{ID: 7, ParentID: 8, Name: "Loop A"},
+		{ID: 8, ParentID: 7, Name: "Loop B"},
+		{ID: 9, ParentID: 9, Name: "Self"},
+	})
+}
+
+func TestCategoryTree_Roots(t *testing.T) {
+	tree := testCategoryTree()
+	var names []string
+	for _, root := range tree.Roots {
+		names = append(names, root.Name)
+	}
+	assert.Equal(t, []string{"Loop B", "Orphan", "Self", "Accessories", "Apparel"}, names)
+	assert.Nil(t, tree.Node(6).Parent)
+}
+
+func TestCategoryTree_Children(t *testing.T) {
+	tree := testCategoryTree()
+	apparel := tree.Node(1)
+	assert.Len(t, apparel.Children, 2)
+	assert.Equal(t, "Shirts", apparel.Children[0].Name)
+	assert.Equal(t, "Shoes", apparel.Children[1].Name)
+	assert.Equal(t, apparel, apparel.Children[1].Parent)
+	assert.Equal(t, 2, apparel.Child("Shoes").ID)
+	assert.Nil(t, apparel.Child("Hats"))
+}
+
+func TestCategoryTree_Find(t *testing.T) {
+	tree := testCategoryTree()
+	assert.Equal(t, 4, tree.Find("Apparel/Shoes/Running").ID)
+	assert.Equal(t, 1, tree.Find("/Apparel/").ID)
+	assert.Nil(t, tree.Find("Apparel/Hats/Running"))
+	assert.Nil(t, tree.Find("Hats"))
+}
+
+func TestCategoryTree_Path(t *testing.T) {
+	tree := testCategoryTree()
+	assert.Equal(t, "Apparel/Shoes/Running", tree.Node(4).Path())
+	assert.Equal(t, "Accessories", tree.Node(5).Path())
+	assert.Nil(t, tree.Node(42))
+}
+
+func TestCategoryTree_Cycle(t *testing.T) {
+	tree := testCategoryTree()
+	assert.Nil(t, tree.Node(8).Parent)
+	assert.Equal(t, tree.Node(8), tree.Node(7).Parent)
+	assert.Equal(t, "Loop B/Loop A", tree.Node(7).Path())
+	assert.Equal(t, 7, tree.Find("Loop B/Loop A").ID)
+	assert.Equal(t, "Self", tree.Node(9).Path())
+	assert.Empty(t, tree.Node(9).Children)
+}
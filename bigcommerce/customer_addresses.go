@@ -0,0 +1,168 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"strings"
+
+	"context"
+)
+
+// AddressType defines whether an address is residential or commercial.
+type AddressType string
+
+// Address types supported by Bigcommerce.
+const (
+	AddressTypeResidential AddressType = "residential"
+	AddressTypeCommercial  AddressType = "commercial"
+)
+
+// CustomerAddress describes the customer address resource.
+// It contains an ID, CustomerID, AddressType and an AddressEntity, whose Email and ShippingMethod are not used.
+type CustomerAddress struct {
+	AddressEntity
+	ID          int         `json:"id"`
+	CustomerID  int         `json:"customer_id"`
+	AddressType AddressType `json:"address_type"`
+}
+
+// CustomerAddressService adds the APIs for the CustomerAddress resource.
+type CustomerAddressService struct {
+	client *Client
+}
+
+func newCustomerAddressService(client *Client) *CustomerAddressService {
+	return &CustomerAddressService{
+		client: client,
+	}
+}
+
+// CustomerAddressListParams are the parameters for CustomerAddressService.List
+type CustomerAddressListParams struct {
+	Page  int `url:"page,omitempty"`
+	Limit int `url:"limit,omitempty"`
+}
+
+// List returns a list of CustomerAddresses of the given Customer matching the given CustomerAddressListParams.
+func (s *CustomerAddressService) List(ctx context.Context, customerID int, params *CustomerAddressListParams) ([]CustomerAddress, *Response, error) {
+	var addresses []CustomerAddress
+
+	response, err := performGET(ctx, s.client, s.servicePath(customerID), params, &addresses)
+
+	return addresses, response, err
+}
+
+// CustomerAddressIterator iterates over the CustomerAddresses of all pages.
+type CustomerAddressIterator struct {
+	iterator
+	addresses []CustomerAddress
+}
+
+// Next advances the iterator to the next CustomerAddress. It returns false once all pages are consumed or an error occurred.
+func (it *CustomerAddressIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current CustomerAddress. It is only valid after Next returned true.
+func (it *CustomerAddressIterator) Value() CustomerAddress {
+	return it.addresses[it.index]
+}
+
+// ListAll returns a CustomerAddressIterator over the CustomerAddresses of all pages for the given Customer.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *CustomerAddressService) ListAll(ctx context.Context, customerID int, params *CustomerAddressListParams) *CustomerAddressIterator {
+	var p CustomerAddressListParams
+	if params != nil {
+		p = *params
+	}
+	it := &CustomerAddressIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		addresses, _, err := s.List(ctx, customerID, &p)
+		it.addresses = addresses
+		return len(addresses), err
+	})
+	return it
+}
+
+// Count returns the number of CustomerAddresses of the given Customer.
+func (s *CustomerAddressService) Count(ctx context.Context, customerID int, params *CustomerAddressListParams) (int, *Response, error) {
+	var cnt count
+
+	path := strings.Join([]string{s.servicePath(customerID), "count"}, "/")
+	response, err := performGET(ctx, s.client, path, params, &cnt)
+
+	return cnt.Count, response, err
+}
+
+// Show returns the requested CustomerAddress.
+func (s *CustomerAddressService) Show(ctx context.Context, customerID int, id int) (*CustomerAddress, *Response, error) {
+	address := new(CustomerAddress)
+
+	path := fmt.Sprintf("%v/%d", s.servicePath(customerID), id)
+	response, err := performGET(ctx, s.client, path, nil, address)
+
+	return address, response, err
+}
+
+// CustomerAddressBody describes the address information given when creating or editing a CustomerAddress.
+// FirstName, LastName, Phone, Street1, City, State, Zip and Country are required when creating an address.
+// Unset fields are left untouched when editing.
+type CustomerAddressBody struct {
+	FirstName   string      `json:"first_name,omitempty"`
+	LastName    string      `json:"last_name,omitempty"`
+	Company     string      `json:"company,omitempty"`
+	Street1     string      `json:"street_1,omitempty"`
+	Street2     string      `json:"street_2,omitempty"`
+	City        string      `json:"city,omitempty"`
+	State       string      `json:"state,omitempty"`
+	Zip         string      `json:"zip,omitempty"`
+	Country     string      `json:"country,omitempty"`
+	Phone       string      `json:"phone,omitempty"`
+	AddressType AddressType `json:"address_type,omitempty"`
+}
+
+// NewCustomerAddressBody returns a CustomerAddressBody holding the fields of the given AddressEntity,
+// e.g. the AddressEntity of an OrderShippingAddress.
+func NewCustomerAddressBody(address AddressEntity) *CustomerAddressBody {
+	return &CustomerAddressBody{
+		FirstName: address.FirstName,
+		LastName:  address.LastName,
+		Company:   address.Company,
+		Street1:   address.Street1,
+		Street2:   address.Street2,
+		City:      address.City,
+		State:     address.State,
+		Zip:       address.Zip,
+		Country:   address.Country,
+		Phone:     address.Phone,
+	}
+}
+
+// New creates a new CustomerAddress for the given Customer and returns the new address.
+func (s *CustomerAddressService) New(ctx context.Context, customerID int, body *CustomerAddressBody) (*CustomerAddress, *Response, error) {
+	address := new(CustomerAddress)
+
+	response, err := performPOST(ctx, s.client, s.servicePath(customerID), nil, body, address)
+
+	return address, response, err
+}
+
+// Edit updates the given CustomerAddress with the given CustomerAddressBody.
+func (s *CustomerAddressService) Edit(ctx context.Context, customerID int, id int, body *CustomerAddressBody) (*CustomerAddress, *Response, error) {
+	address := new(CustomerAddress)
+
+	path := fmt.Sprintf("%v/%d", s.servicePath(customerID), id)
+	response, err := performPUT(ctx, s.client, path, nil, body, address)
+
+	return address, response, err
+}
+
+// Delete deletes the given CustomerAddress.
+func (s *CustomerAddressService) Delete(ctx context.Context, customerID int, id int) (*Response, error) {
+	path := fmt.Sprintf("%v/%d", s.servicePath(customerID), id)
+	return performDELETE(ctx, s.client, path, nil)
+}
+
+func (s *CustomerAddressService) servicePath(customerID int) string {
+	return fmt.Sprintf("customers/%d/addresses", customerID)
+}
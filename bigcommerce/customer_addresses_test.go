@@ -0,0 +1,406 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomerAddressService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 123 }]`)
+	})
+
+	expected := []CustomerAddress{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerAddressListParams{
+		Page: 1,
+	}
+	addresses, _, err := client.CustomerAddresses.List(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, addresses)
+}
+
+func TestCustomerAddressService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerAddressListParams{
+		Page: 1,
+	}
+	addresses, _, err := client.CustomerAddresses.List(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(addresses) == 0)
+}
+
+func TestCustomerAddressService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var addresses []CustomerAddress
+	it := client.CustomerAddresses.ListAll(context.Background(), 12, nil)
+	for it.Next() {
+		addresses = append(addresses, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []CustomerAddress{{ID: 1}, {ID: 2}, {ID: 3}}, addresses)
+}
+
+func TestCustomerAddressService_Count(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "count": 12 }`)
+	})
+
+	expected := 12
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerAddressListParams{
+		Limit: 10,
+	}
+	count, _, err := client.CustomerAddresses.Count(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, count)
+}
+
+func TestCustomerAddressService_CountWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerAddressListParams{
+		Limit: 10,
+	}
+	_, _, err := client.CustomerAddresses.Count(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerAddressService_Show(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &CustomerAddress{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	address, _, err := client.CustomerAddresses.Show(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, address)
+}
+
+func TestCustomerAddressService_ShowWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, _, err := client.CustomerAddresses.Show(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerAddressService_New(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &CustomerAddress{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &CustomerAddressBody{
+		FirstName: "Jane",
+		LastName:  "Doe",
+		Street1:   "1 Main St",
+	}
+	address, _, err := client.CustomerAddresses.New(context.Background(), 12, body)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, address)
+}
+
+func TestCustomerAddressService_NewWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &CustomerAddressBody{
+		FirstName: "Jane",
+		LastName:  "Doe",
+		Street1:   "1 Main St",
+	}
+	_, _, err := client.CustomerAddresses.New(context.Background(), 12, body)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerAddressService_Edit(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &CustomerAddress{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerAddressBody{
+		AddressType: AddressTypeCommercial,
+	}
+	address, _, err := client.CustomerAddresses.Edit(context.Background(), 12, 3, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, address)
+}
+
+func TestCustomerAddressService_EditWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerAddressBody{
+		AddressType: AddressTypeCommercial,
+	}
+	_, _, err := client.CustomerAddresses.Edit(context.Background(), 12, 3, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerAddressService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	response, err := client.CustomerAddresses.Delete(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestCustomerAddressService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, err := client.CustomerAddresses.Delete(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerAddressService_ShowAddressEntity(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "id": 3,
+  "customer_id": 12,
+  "first_name": "Jane",
+  "last_name": "Doe",
+  "company": "",
+  "street_1": "1 Main St",
+  "street_2": "",
+  "city": "Austin",
+  "state": "Texas",
+  "zip": "78701",
+  "country": "United States",
+  "country_iso2": "US",
+  "phone": "555-0100",
+  "address_type": "residential"
+}`)
+	})
+
+	expected := &CustomerAddress{
+		AddressEntity: AddressEntity{
+			FirstName:   "Jane",
+			LastName:    "Doe",
+			Street1:     "1 Main St",
+			City:        "Austin",
+			State:       "Texas",
+			Zip:         "78701",
+			Country:     "United States",
+			CountryIso2: "US",
+			Phone:       "555-0100",
+		},
+		ID:          3,
+		CustomerID:  12,
+		AddressType: AddressTypeResidential,
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	address, _, err := client.CustomerAddresses.Show(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, address)
+}
+
+func TestCustomerAddressService_NewFromAddressEntity(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12/addresses", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{
+  "first_name": "Jane",
+  "last_name": "Doe",
+  "street_1": "1 Main St",
+  "city": "Austin",
+  "state": "Texas",
+  "zip": "78701",
+  "country": "United States",
+  "phone": "555-0100"
+}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 4 }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	shippingAddress := OrderShippingAddress{
+		AddressEntity: AddressEntity{
+			FirstName:      "Jane",
+			LastName:       "Doe",
+			Street1:        "1 Main St",
+			City:           "Austin",
+			State:          "Texas",
+			Zip:            "78701",
+			Country:        "United States",
+			CountryIso2:    "US",
+			Phone:          "555-0100",
+			Email:          "jane@example.com",
+			ShippingMethod: "Flat Rate",
+		},
+	}
+	body := NewCustomerAddressBody(shippingAddress.AddressEntity)
+	address, _, err := client.CustomerAddresses.New(context.Background(), 12, body)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, address.ID)
+}
@@ -0,0 +1,192 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"strings"
+
+	"context"
+)
+
+const customerGroupServicePath = "customer_groups/"
+
+// CategoryAccessType defines which categories the customers of a CustomerGroup can access.
+type CategoryAccessType string
+
+// Category access types supported by Bigcommerce.
+const (
+	CategoryAccessTypeAll      CategoryAccessType = "all"
+	CategoryAccessTypeSpecific CategoryAccessType = "specific"
+	CategoryAccessTypeNone     CategoryAccessType = "none"
+)
+
+// DiscountRuleType defines what a DiscountRule applies to.
+type DiscountRuleType string
+
+// Discount rule types supported by Bigcommerce.
+const (
+	DiscountRuleTypePriceList DiscountRuleType = "price_list"
+	DiscountRuleTypeCategory  DiscountRuleType = "category"
+	DiscountRuleTypeProduct   DiscountRuleType = "product"
+	DiscountRuleTypeAll       DiscountRuleType = "all"
+)
+
+// DiscountMethod defines how the Amount of a DiscountRule is applied.
+type DiscountMethod string
+
+// Discount methods supported by Bigcommerce.
+const (
+	DiscountMethodPercent DiscountMethod = "percent"
+	DiscountMethodFixed   DiscountMethod = "fixed"
+	DiscountMethodPrice   DiscountMethod = "price"
+)
+
+// CustomerGroup describes the customer group resource
+type CustomerGroup struct {
+	ID             int            `json:"id"`
+	Name           string         `json:"name"`
+	IsDefault      bool           `json:"is_default"`
+	CategoryAccess CategoryAccess `json:"category_access"`
+	DiscountRules  []DiscountRule `json:"discount_rules"`
+}
+
+// CategoryAccess describes the categories the customers of a CustomerGroup can access.
+// Categories is only used with CategoryAccessTypeSpecific.
+type CategoryAccess struct {
+	Type       CategoryAccessType `json:"type"`
+	Categories []int              `json:"categories,omitempty"`
+}
+
+// DiscountRule describes a discount given to the customers of a CustomerGroup.
+// PriceListID, CategoryID and ProductID are used with the matching DiscountRuleType.
+type DiscountRule struct {
+	Type        DiscountRuleType `json:"type"`
+	Method      DiscountMethod   `json:"method,omitempty"`
+	Amount      float64          `json:"amount,string,omitempty"`
+	PriceListID int              `json:"price_list_id,omitempty"`
+	CategoryID  int              `json:"category_id,omitempty"`
+	ProductID   int              `json:"product_id,omitempty"`
+}
+
+// CustomerGroupService adds the APIs for the CustomerGroup resource.
+type CustomerGroupService struct {
+	client *Client
+}
+
+func newCustomerGroupService(client *Client) *CustomerGroupService {
+	return &CustomerGroupService{
+		client: client,
+	}
+}
+
+// CustomerGroupListParams are the parameters for CustomerGroupService.List
+type CustomerGroupListParams struct {
+	Page      int    `url:"page,omitempty"`
+	Limit     int    `url:"limit,omitempty"`
+	Name      string `url:"name,omitempty"`
+	IsDefault *bool  `url:"is_default,omitempty"`
+}
+
+// List returns a list of CustomerGroups matching the given CustomerGroupListParams.
+func (s *CustomerGroupService) List(ctx context.Context, params *CustomerGroupListParams) ([]CustomerGroup, *Response, error) {
+	var groups []CustomerGroup
+
+	response, err := performGET(ctx, s.client, customerGroupServicePath, params, &groups)
+
+	return groups, response, err
+}
+
+// CustomerGroupIterator iterates over the CustomerGroups of all pages matching the given CustomerGroupListParams.
+type CustomerGroupIterator struct {
+	iterator
+	groups []CustomerGroup
+}
+
+// Next advances the iterator to the next CustomerGroup. It returns false once all pages are consumed or an error occurred.
+func (it *CustomerGroupIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current CustomerGroup. It is only valid after Next returned true.
+func (it *CustomerGroupIterator) Value() CustomerGroup {
+	return it.groups[it.index]
+}
+
+// ListAll returns a CustomerGroupIterator over the CustomerGroups of all pages matching the given CustomerGroupListParams.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *CustomerGroupService) ListAll(ctx context.Context, params *CustomerGroupListParams) *CustomerGroupIterator {
+	var p CustomerGroupListParams
+	if params != nil {
+		p = *params
+	}
+	it := &CustomerGroupIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		groups, _, err := s.List(ctx, &p)
+		it.groups = groups
+		return len(groups), err
+	})
+	return it
+}
+
+// Count returns the number of CustomerGroups matching the given CustomerGroupListParams.
+func (s *CustomerGroupService) Count(ctx context.Context, params *CustomerGroupListParams) (int, *Response, error) {
+	var cnt count
+
+	path := strings.Join([]string{customerGroupServicePath, "count"}, "")
+	response, err := performGET(ctx, s.client, path, params, &cnt)
+
+	return cnt.Count, response, err
+}
+
+// Show returns the requested CustomerGroup.
+func (s *CustomerGroupService) Show(ctx context.Context, id int) (*CustomerGroup, *Response, error) {
+	group := new(CustomerGroup)
+
+	path := fmt.Sprintf("%v%v", customerGroupServicePath, id)
+	response, err := performGET(ctx, s.client, path, nil, group)
+
+	return group, response, err
+}
+
+// CustomerGroupBody describes the customer group information given when creating or editing a CustomerGroup.
+// Name is required when creating a customer group. Unset fields are left untouched when editing.
+// DiscountRules replace all discount rules of the group when set, so a pointer to an empty
+// slice removes all discount rules.
+type CustomerGroupBody struct {
+	Name           string          `json:"name,omitempty"`
+	IsDefault      *bool           `json:"is_default,omitempty"`
+	CategoryAccess *CategoryAccess `json:"category_access,omitempty"`
+	DiscountRules  *[]DiscountRule `json:"discount_rules,omitempty"`
+}
+
+// New creates a new CustomerGroup with the specified information and returns the new customer group.
+func (s *CustomerGroupService) New(ctx context.Context, body *CustomerGroupBody) (*CustomerGroup, *Response, error) {
+	group := new(CustomerGroup)
+
+	response, err := performPOST(ctx, s.client, customerGroupServicePath, nil, body, group)
+
+	return group, response, err
+}
+
+// Edit updates the given CustomerGroup with the given CustomerGroupBody.
+func (s *CustomerGroupService) Edit(ctx context.Context, id int, body *CustomerGroupBody) (*CustomerGroup, *Response, error) {
+	group := new(CustomerGroup)
+
+	path := fmt.Sprintf("%v%v", customerGroupServicePath, id)
+	response, err := performPUT(ctx, s.client, path, nil, body, group)
+
+	return group, response, err
+}
+
+// Delete deletes the given CustomerGroup.
+func (s *CustomerGroupService) Delete(ctx context.Context, id int) (*Response, error) {
+	path := fmt.Sprintf("%v%v", customerGroupServicePath, id)
+	return performDELETE(ctx, s.client, path, nil)
+}
+
+// AssignCustomer moves the given Customer into the given CustomerGroup and returns the updated customer.
+// A groupID of 0 removes the customer from its group. The customers of a group are listed by
+// CustomerService.List with CustomerListParams.CustomerGroupID.
+func (s *CustomerGroupService) AssignCustomer(ctx context.Context, groupID int, customerID int) (*Customer, *Response, error) {
+	return s.client.Customers.Edit(ctx, customerID, &CustomerBody{CustomerGroupID: &groupID})
+}
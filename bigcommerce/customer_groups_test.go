@@ -0,0 +1,448 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomerGroupService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 123 }]`)
+	})
+
+	expected := []CustomerGroup{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerGroupListParams{
+		Page: 1,
+	}
+	groups, _, err := client.CustomerGroups.List(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, groups)
+}
+
+func TestCustomerGroupService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerGroupListParams{
+		Page: 1,
+	}
+	groups, _, err := client.CustomerGroups.List(context.Background(), params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(groups) == 0)
+}
+
+func TestCustomerGroupService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var groups []CustomerGroup
+	it := client.CustomerGroups.ListAll(context.Background(), nil)
+	for it.Next() {
+		groups = append(groups, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []CustomerGroup{{ID: 1}, {ID: 2}, {ID: 3}}, groups)
+}
+
+func TestCustomerGroupService_Count(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "count": 12 }`)
+	})
+
+	expected := 12
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerGroupListParams{
+		Limit: 10,
+	}
+	count, _, err := client.CustomerGroups.Count(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, count)
+}
+
+func TestCustomerGroupService_CountWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerGroupListParams{
+		Limit: 10,
+	}
+	_, _, err := client.CustomerGroups.Count(context.Background(), params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerGroupService_Show(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &CustomerGroup{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	group, _, err := client.CustomerGroups.Show(context.Background(), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, group)
+}
+
+func TestCustomerGroupService_ShowWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, _, err := client.CustomerGroups.Show(context.Background(), 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerGroupService_New(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &CustomerGroup{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &CustomerGroupBody{
+		Name: "Wholesale",
+	}
+	group, _, err := client.CustomerGroups.New(context.Background(), body)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, group)
+}
+
+func TestCustomerGroupService_NewWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &CustomerGroupBody{
+		Name: "Wholesale",
+	}
+	_, _, err := client.CustomerGroups.New(context.Background(), body)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerGroupService_Edit(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &CustomerGroup{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerGroupBody{
+		Name: "Retail",
+	}
+	group, _, err := client.CustomerGroups.Edit(context.Background(), 3, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, group)
+}
+
+func TestCustomerGroupService_EditWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerGroupBody{
+		Name: "Retail",
+	}
+	_, _, err := client.CustomerGroups.Edit(context.Background(), 3, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerGroupService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	response, err := client.CustomerGroups.Delete(context.Background(), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestCustomerGroupService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, err := client.CustomerGroups.Delete(context.Background(), 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerGroupService_ShowDiscountRules(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "id": 3,
+  "name": "Wholesale",
+  "is_default": false,
+  "category_access": { "type": "specific", "categories": [18, 23] },
+  "discount_rules": [
+    { "type": "price_list", "price_list_id": 2 },
+    { "type": "category", "method": "percent", "amount": "10.0000", "category_id": 18 },
+    { "type": "product", "method": "price", "amount": "4.5000", "product_id": 77 },
+    { "type": "all", "method": "fixed", "amount": "1.0000" }
+  ]
+}`)
+	})
+
+	expected := &CustomerGroup{
+		ID:   3,
+		Name: "Wholesale",
+		CategoryAccess: CategoryAccess{
+			Type:       CategoryAccessTypeSpecific,
+			Categories: []int{18, 23},
+		},
+		DiscountRules: []DiscountRule{
+			{Type: DiscountRuleTypePriceList, PriceListID: 2},
+			{Type: DiscountRuleTypeCategory, Method: DiscountMethodPercent, Amount: 10, CategoryID: 18},
+			{Type: DiscountRuleTypeProduct, Method: DiscountMethodPrice, Amount: 4.5, ProductID: 77},
+			{Type: DiscountRuleTypeAll, Method: DiscountMethodFixed, Amount: 1},
+		},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	group, _, err := client.CustomerGroups.Show(context.Background(), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, group)
+}
+
+func TestCustomerGroupService_NewBody(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{
+  "name": "Wholesale",
+  "category_access": { "type": "all" },
+  "discount_rules": [{ "type": "all", "method": "percent", "amount": "15" }]
+}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 4 }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &CustomerGroupBody{
+		Name:           "Wholesale",
+		CategoryAccess: &CategoryAccess{Type: CategoryAccessTypeAll},
+		DiscountRules: &[]DiscountRule{
+			{Type: DiscountRuleTypeAll, Method: DiscountMethodPercent, Amount: 15},
+		},
+	}
+	group, _, err := client.CustomerGroups.New(context.Background(), body)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, group.ID)
+}
+
+func TestCustomerGroupService_EditClearDiscountRules(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customer_groups/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{ "discount_rules": [] }`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 3, "discount_rules": [] }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerGroupBody{
+		DiscountRules: &[]DiscountRule{},
+	}
+	group, _, err := client.CustomerGroups.Edit(context.Background(), 3, params)
+	assert.Nil(t, err)
+	assert.Empty(t, group.DiscountRules)
+}
+
+func TestCustomerGroupService_AssignCustomer(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{ "customer_group_id": 3 }`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 12, "customer_group_id": 3 }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	customer, _, err := client.CustomerGroups.AssignCustomer(context.Background(), 3, 12)
+	assert.Nil(t, err)
+	assert.Equal(t, &Customer{ID: 12, CustomerGroupID: 3}, customer)
+}
+
+func TestCustomerGroupService_AssignCustomerWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/12", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, _, err := client.CustomerGroups.AssignCustomer(context.Background(), 3, 12)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
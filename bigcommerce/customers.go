@@ -0,0 +1,170 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"context"
+)
+
+const customerServicePath = "customers/"
+
+// Customer describes the customer resource
+type Customer struct {
+	ID                    int     `json:"id"`
+	Company               string  `json:"company"`
+	FirstName             string  `json:"first_name"`
+	LastName              string  `json:"last_name"`
+	Email                 string  `json:"email"`
+	Phone                 string  `json:"phone"`
+	DateCreated           BCTime  `json:"date_created"`
+	DateModified          BCTime  `json:"date_modified"`
+	StoreCredit           float64 `json:"store_credit,string"`
+	RegistrationIPAddress string  `json:"registration_ip_address"`
+	CustomerGroupID       int     `json:"customer_group_id"`
+	Notes                 string  `json:"notes"`
+	TaxExemptCategory     string  `json:"tax_exempt_category"`
+	AcceptsMarketing      bool    `json:"accepts_marketing"`
+}
+
+// CustomerService adds the APIs for the Customer resource.
+type CustomerService struct {
+	client *Client
+}
+
+func newCustomerService(client *Client) *CustomerService {
+	return &CustomerService{
+		client: client,
+	}
+}
+
+// CustomerListParams are the parameters for CustomerService.List
+type CustomerListParams struct {
+	Page              int       `url:"page,omitempty"`
+	Limit             int       `url:"limit,omitempty"`
+	MinID             int       `url:"min_id,omitempty"`
+	MaxID             int       `url:"max_id,omitempty"`
+	FirstName         string    `url:"first_name,omitempty"`
+	LastName          string    `url:"last_name,omitempty"`
+	Company           string    `url:"company,omitempty"`
+	Email             string    `url:"email,omitempty"`
+	Phone             string    `url:"phone,omitempty"`
+	CustomerGroupID   *int      `url:"customer_group_id,omitempty"`
+	TaxExemptCategory string    `url:"tax_exempt_category,omitempty"`
+	MinDateCreated    time.Time `url:"min_date_created,omitempty"`
+	MaxDateCreated    time.Time `url:"max_date_created,omitempty"`
+	MinDateModified   time.Time `url:"min_date_modified,omitempty"`
+	MaxDateModified   time.Time `url:"max_date_modified,omitempty"`
+}
+
+// List returns a list of Customers matching the given CustomerListParams.
+func (s *CustomerService) List(ctx context.Context, params *CustomerListParams) ([]Customer, *Response, error) {
+	var customers []Customer
+
+	response, err := performGET(ctx, s.client, customerServicePath, params, &customers)
+
+	return customers, response, err
+}
+
+// CustomerIterator iterates over the Customers of all pages matching the given CustomerListParams.
+type CustomerIterator struct {
+	iterator
+	customers []Customer
+}
+
+// Next advances the iterator to the next Customer. It returns false once all pages are consumed or an error occurred.
+func (it *CustomerIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current Customer. It is only valid after Next returned true.
+func (it *CustomerIterator) Value() Customer {
+	return it.customers[it.index]
+}
+
+// ListAll returns a CustomerIterator over the Customers of all pages matching the given CustomerListParams.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *CustomerService) ListAll(ctx context.Context, params *CustomerListParams) *CustomerIterator {
+	var p CustomerListParams
+	if params != nil {
+		p = *params
+	}
+	it := &CustomerIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		customers, _, err := s.List(ctx, &p)
+		it.customers = customers
+		return len(customers), err
+	})
+	return it
+}
+
+// Count returns the number of Customers matching the given CustomerListParams.
+func (s *CustomerService) Count(ctx context.Context, params *CustomerListParams) (int, *Response, error) {
+	var cnt count
+
+	path := strings.Join([]string{customerServicePath, "count"}, "")
+	response, err := performGET(ctx, s.client, path, params, &cnt)
+
+	return cnt.Count, response, err
+}
+
+// Show returns the requested Customer.
+func (s *CustomerService) Show(ctx context.Context, id int) (*Customer, *Response, error) {
+	customer := new(Customer)
+
+	path := fmt.Sprintf("%v%v", customerServicePath, id)
+	response, err := performGET(ctx, s.client, path, nil, customer)
+
+	return customer, response, err
+}
+
+// CustomerAuthentication describes the password of a Customer given when creating or editing a Customer.
+type CustomerAuthentication struct {
+	ForceReset           bool   `json:"force_reset,omitempty"`
+	Password             string `json:"password,omitempty"`
+	PasswordConfirmation string `json:"password_confirmation,omitempty"`
+}
+
+// CustomerBody describes the customer information given when creating or editing a Customer.
+// FirstName, LastName and Email are required when creating a customer. Unset fields are left untouched when editing.
+type CustomerBody struct {
+	Company               string                  `json:"company,omitempty"`
+	FirstName             string                  `json:"first_name,omitempty"`
+	LastName              string                  `json:"last_name,omitempty"`
+	Email                 string                  `json:"email,omitempty"`
+	Phone                 string                  `json:"phone,omitempty"`
+	StoreCredit           *float64                `json:"store_credit,string,omitempty"`
+	RegistrationIPAddress string                  `json:"registration_ip_address,omitempty"`
+	CustomerGroupID       *int                    `json:"customer_group_id,omitempty"`
+	Notes                 string                  `json:"notes,omitempty"`
+	TaxExemptCategory     string                  `json:"tax_exempt_category,omitempty"`
+	AcceptsMarketing      *bool                   `json:"accepts_marketing,omitempty"`
+	Authentication        *CustomerAuthentication `json:"_authentication,omitempty"`
+}
+
+// New creates a new Customer with the specified information and returns the new customer.
+func (s *CustomerService) New(ctx context.Context, body *CustomerBody) (*Customer, *Response, error) {
+	customer := new(Customer)
+
+	response, err := performPOST(ctx, s.client, customerServicePath, nil, body, customer)
+
+	return customer, response, err
+}
+
+// Edit updates the given Customer with the given CustomerBody.
+func (s *CustomerService) Edit(ctx context.Context, id int, body *CustomerBody) (*Customer, *Response, error) {
+	customer := new(Customer)
+
+	path := fmt.Sprintf("%v%v", customerServicePath, id)
+	response, err := performPUT(ctx, s.client, path, nil, body, customer)
+
+	return customer, response, err
+}
+
+// Delete deletes the given Customer.
+func (s *CustomerService) Delete(ctx context.Context, id int) (*Response, error) {
+	path := fmt.Sprintf("%v%v", customerServicePath, id)
+	return performDELETE(ctx, s.client, path, nil)
+}
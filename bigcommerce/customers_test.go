@@ -0,0 +1,388 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomerService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 123 }]`)
+	})
+
+	expected := []Customer{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerListParams{
+		Page: 1,
+	}
+	customers, _, err := client.Customers.List(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, customers)
+}
+
+func TestCustomerService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerListParams{
+		Page: 1,
+	}
+	customers, _, err := client.Customers.List(context.Background(), params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(customers) == 0)
+}
+
+func TestCustomerService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var customers []Customer
+	it := client.Customers.ListAll(context.Background(), nil)
+	for it.Next() {
+		customers = append(customers, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []Customer{{ID: 1}, {ID: 2}, {ID: 3}}, customers)
+}
+
+func TestCustomerService_Count(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "count": 12 }`)
+	})
+
+	expected := 12
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerListParams{
+		Limit: 10,
+	}
+	count, _, err := client.Customers.Count(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, count)
+}
+
+func TestCustomerService_CountWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerListParams{
+		Limit: 10,
+	}
+	_, _, err := client.Customers.Count(context.Background(), params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerService_Show(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &Customer{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	customer, _, err := client.Customers.Show(context.Background(), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, customer)
+}
+
+func TestCustomerService_ShowWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, _, err := client.Customers.Show(context.Background(), 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerService_New(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &Customer{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &CustomerBody{
+		FirstName: "Jane",
+		LastName:  "Doe",
+		Email:     "jane@example.com",
+	}
+	customer, _, err := client.Customers.New(context.Background(), body)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, customer)
+}
+
+func TestCustomerService_NewWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &CustomerBody{
+		FirstName: "Jane",
+		LastName:  "Doe",
+		Email:     "jane@example.com",
+	}
+	_, _, err := client.Customers.New(context.Background(), body)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerService_Edit(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &Customer{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerBody{
+		Phone: "555-0100",
+	}
+	customer, _, err := client.Customers.Edit(context.Background(), 3, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, customer)
+}
+
+func TestCustomerService_EditWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &CustomerBody{
+		Phone: "555-0100",
+	}
+	_, _, err := client.Customers.Edit(context.Background(), 3, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	response, err := client.Customers.Delete(context.Background(), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestCustomerService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, err := client.Customers.Delete(context.Background(), 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestCustomerService_ListWithFilters(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{
+			"email":             "jane@example.com",
+			"last_name":         "Doe",
+			"customer_group_id": "0",
+			"min_date_modified": "Wed, 14 Nov 2012 19:26:23 +0000",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{
+  "id": 123,
+  "first_name": "Jane",
+  "last_name": "Doe",
+  "email": "jane@example.com",
+  "store_credit": "12.5000",
+  "customer_group_id": 0,
+  "date_created": "Wed, 14 Nov 2012 19:26:23 +0000",
+  "date_modified": ""
+}]`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	minDateModified := time.Date(2012, time.November, 14, 19, 26, 23, 0, time.UTC)
+	customerGroupID := 0
+	params := &CustomerListParams{
+		Email:           "jane@example.com",
+		LastName:        "Doe",
+		CustomerGroupID: &customerGroupID,
+		MinDateModified: minDateModified,
+	}
+	customers, _, err := client.Customers.List(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Len(t, customers, 1)
+	assert.Equal(t, 12.5, customers[0].StoreCredit)
+	assert.Equal(t, 2012, customers[0].DateCreated.Time().Year())
+	assert.Nil(t, customers[0].DateModified.Time())
+}
+
+func TestCustomerService_EditBody(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/customers/123", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{
+  "store_credit": "0",
+  "customer_group_id": 4,
+  "_authentication": { "force_reset": true }
+}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123, "store_credit": "0.0000", "customer_group_id": 4 }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	storeCredit := 0.0
+	customerGroupID := 4
+	params := &CustomerBody{
+		StoreCredit:     &storeCredit,
+		CustomerGroupID: &customerGroupID,
+		Authentication:  &CustomerAuthentication{ForceReset: true},
+	}
+	customer, _, err := client.Customers.Edit(context.Background(), 123, params)
+	assert.Nil(t, err)
+	assert.Equal(t, &Customer{ID: 123, CustomerGroupID: 4}, customer)
+}
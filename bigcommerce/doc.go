@@ -1,5 +1,5 @@
 /*
-Package bigcommerce provides an api client for communicating with Bigcommerce REST APIs V2.
+Package bigcommerce provides an api client for communicating with Bigcommerce REST APIs V2 and V3.
 The official API documentation can be found on: https://developer.bigcommerce.com/api/v2/
 
 Configure and initialize the client:
@@ -10,6 +10,64 @@ Configure and initialize the client:
     Password: "12345"}
   client := bigcommerce.NewClient(http.DefaultClient, config)
 
+OAuth API accounts are configured with the store hash, client ID and access token instead:
+
+  config := &bigcommerce.ClientConfig{
+    StoreHash:   "abc123",
+    ClientID:    "client-id",
+    AccessToken: "access-token"}
+  client := bigcommerce.NewClient(http.DefaultClient, config)
+
+Custom Requests
+
+Endpoints not wrapped by a service can be requested with NewRequest and Do. V3 responses are decoded through a V3Response:
+
+  req, err := client.NewRequest(context.Background(), "GET", "v3/catalog/summary", nil, nil)
+  var summary CatalogSummary
+  resp, err := client.Do(req, &bigcommerce.V3Response{Data: &summary})
+
+Errors
+
+Requests failing with a non 2XX status return an *Error holding the status, request and decoded error messages.
+Common failures can be matched with errors.Is:
+
+  order, resp, err := client.Orders.Show(context.Background(), 12)
+  if errors.Is(err, bigcommerce.ErrNotFound) {
+  }
+
+Responses
+
+Service methods return a *Response wrapping the *http.Response with the parsed rate limit, V3 pagination and request ID:
+
+  orders, resp, err := client.Orders.List(context.Background(), &bigcommerce.OrderListParams{})
+  left := resp.RateLimit.RequestsLeft
+
+Retries
+
+Requests failing with 429 Too Many Requests or a transient 5xx status are retried when a RetryPolicy is set:
+
+  client.RetryPolicy = bigcommerce.DefaultRetryPolicy()
+
+Rate Limits
+
+The client tracks the X-Rate-Limit headers of the responses and blocks requests until the quota resets
+once it is exhausted. The quota is shared by all services of a client, so use a single client per store.
+
+Brands
+
+Resolve the ID of the brand named "ACME", creating the brand if necessary. Resolved IDs are cached by the client
+
+  brandID, err := client.Brands.ResolveOrCreate(context.Background(), "ACME")
+
+Categories
+
+Build the category tree of the store and look up a category by its path
+
+  tree, err := client.Categories.Tree(context.Background())
+  if node := tree.Find("Apparel/Shoes/Running"); node != nil {
+    categoryID := node.ID
+  }
+
 Products
 
 Request a list of products with ID >= 2
@@ -18,6 +76,31 @@ Request a list of products with ID >= 2
     MinID: 2,
   })
 
+Iterate over all products of category 18, fetching 250 products per page
+
+  it := client.Products.ListAll(context.Background(), &bigcommerce.ProductListParams{
+    Category: 18,
+    Limit:    250,
+  })
+  for it.Next() {
+    product := it.Value()
+  }
+  if err := it.Err(); err != nil {
+    // handle error
+  }
+
+Create a new product
+
+  product, resp, err := client.Products.New(context.Background(), &bigcommerce.ProductBody{
+    Name:         "Plain T-Shirt",
+    Type:         bigcommerce.ProductTypePhysical,
+    Price:        29.99,
+    Weight:       0.5,
+    Categories:   []int{18},
+    Availability: bigcommerce.ProductAvailabilityAvailable,
+    IsVisible:    true,
+  })
+
 ProductCustomFields
 
 Request a list of ProductCustomFields for products with ID >= 2
@@ -26,6 +109,82 @@ Request a list of ProductCustomFields for products with ID >= 2
     Page: 1,
   })
 
+Set the text of the custom field named "Supplier" on product 2, creating the custom field if necessary
+
+  customField, resp, err := client.ProductCustomFields.Upsert(context.Background(), 2, "Supplier", "ACME")
+
+ProductImages
+
+Upload an image file for product 2 and use it as thumbnail
+
+  file, err := os.Open("shirt.jpg")
+  image, resp, err := client.ProductImages.Upload(context.Background(), 2, &bigcommerce.ProductImageUpload{
+    FileName:    "shirt.jpg",
+    File:        file,
+    IsThumbnail: true,
+  })
+
+ProductOptions and ProductModifiers
+
+Add a gift wrap modifier to product 2, which adds 5.00 to the price
+
+  required := true
+  modifier, resp, err := client.ProductModifiers.New(context.Background(), 2, &bigcommerce.ProductModifierBody{
+    DisplayName: "Gift wrap",
+    Type:        bigcommerce.OptionTypeRadioButtons,
+    Required:    &required,
+    OptionValues: []bigcommerce.ProductModifierValue{
+      {Label: "No", IsDefault: true},
+      {Label: "Yes", SortOrder: 1, Adjusters: &bigcommerce.ModifierAdjusters{
+        Price: &bigcommerce.ModifierAdjuster{Adjuster: bigcommerce.AdjusterTypeRelative, AdjusterValue: 5},
+      }},
+    },
+  })
+
+ProductVariants
+
+Variants are read from the V3 catalog API when the client is configured with OAuth credentials, and from
+the V2 SKU API otherwise. Set the stock of variant 3 of product 2
+
+  inventoryLevel := 12
+  variant, resp, err := client.ProductVariants.Edit(context.Background(), 2, 3, &bigcommerce.ProductVariantBody{
+    InventoryLevel: &inventoryLevel,
+  })
+
+Customers
+
+Request a list of customers modified since the given time
+
+  customers, resp, err := client.Customers.List(context.Background(), &bigcommerce.CustomerListParams{
+    MinDateModified: since,
+  })
+
+CustomerAddresses
+
+Save the first shipping address of order 12 as address of customer 7
+
+  shippingAddresses, resp, err := client.OrderShippingAddresses.List(context.Background(), 12, nil)
+  body := bigcommerce.NewCustomerAddressBody(shippingAddresses[0].AddressEntity)
+  address, resp, err := client.CustomerAddresses.New(context.Background(), 7, body)
+
+CustomerGroups
+
+Create a wholesale customer group with 10% off category 18 and move customer 7 into it
+
+  group, resp, err := client.CustomerGroups.New(context.Background(), &bigcommerce.CustomerGroupBody{
+    Name: "Wholesale",
+    DiscountRules: &[]bigcommerce.DiscountRule{
+      {Type: bigcommerce.DiscountRuleTypeCategory, Method: bigcommerce.DiscountMethodPercent, Amount: 10, CategoryID: 18},
+    },
+  })
+  customer, resp, err := client.CustomerGroups.AssignCustomer(context.Background(), group.ID, 7)
+
+Remove all discount rules of the customer group
+
+  group, resp, err = client.CustomerGroups.Edit(context.Background(), group.ID, &bigcommerce.CustomerGroupBody{
+    DiscountRules: &[]bigcommerce.DiscountRule{},
+  })
+
 Orders
 
 Request a list of orders with ID >= 2
@@ -34,6 +193,52 @@ Request a list of orders with ID >= 2
     MinID: 2,
   })
 
+Request a list of orders modified since yesterday, most recently modified first
+
+  since := time.Now().Add(-24 * time.Hour)
+  orders, resp, err := client.Orders.List(context.Background(), &bigcommerce.OrderListParams{
+    MinDateModified: since,
+    Sort:            bigcommerce.OrderSortDateModifiedDesc,
+  })
+
+Iterate over the orders of all pages
+
+  it := client.Orders.ListAll(context.Background(), &bigcommerce.OrderListParams{
+    Limit: 250,
+  })
+  for it.Next() {
+    order := it.Value()
+  }
+  if err := it.Err(); err != nil {
+  }
+
+OrderCoupons, OrderTaxes and OrderMessages
+
+Request the coupons, tax lines and messages of Order with ID = 12
+
+  coupons, resp, err := client.OrderCoupons.List(context.Background(), 12, &bigcommerce.OrderCouponListParams{})
+  taxes, resp, err := client.OrderTaxes.List(context.Background(), 12, &bigcommerce.OrderTaxListParams{})
+  messages, resp, err := client.OrderMessages.List(context.Background(), 12, &bigcommerce.OrderMessageListParams{})
+
+OrderProducts
+
+Request a list of products for Order with ID = 12
+
+  items, resp, err := client.OrderProducts.List(context.Background(), 12, &bigcommerce.OrderProductListParams{})
+
+OrderShipments
+
+Ship products of Order with ID = 12 to the order shipping address with ID = 4
+
+  shipment, resp, err := client.OrderShipments.New(context.Background(), 12, &bigcommerce.OrderShipmentBody{
+    OrderAddressID:   4,
+    TrackingNumber:   "1Z999AA10123456784",
+    ShippingProvider: bigcommerce.ShippingProviderUPS,
+    Items: []bigcommerce.OrderShipmentItem{
+      {OrderProductID: 16, Quantity: 1},
+    },
+  })
+
 OrderShippingAddresses
 
 Request a list of order shipping addresses for Order with ID = 12
@@ -10,6 +10,35 @@ type count struct {
 	Count int `json:"count"`
 }
 
+// V3Response describes the data/meta envelope wrapping all V3 API responses.
+// Data is decoded into the value it holds.
+type V3Response struct {
+	Data interface{} `json:"data"`
+	Meta V3Meta      `json:"meta"`
+}
+
+// V3Meta describes the meta object of V3 API responses.
+type V3Meta struct {
+	Pagination *Pagination `json:"pagination"`
+}
+
+// Pagination describes the pagination information returned by V3 list endpoints.
+type Pagination struct {
+	Total       int             `json:"total"`
+	Count       int             `json:"count"`
+	PerPage     int             `json:"per_page"`
+	CurrentPage int             `json:"current_page"`
+	TotalPages  int             `json:"total_pages"`
+	Links       PaginationLinks `json:"links"`
+}
+
+// PaginationLinks describes the links to adjacent pages of V3 list endpoints.
+type PaginationLinks struct {
+	Previous string `json:"previous"`
+	Current  string `json:"current"`
+	Next     string `json:"next"`
+}
+
 // AddressEntities defines a list of the AddressEntity object.
 type AddressEntities []AddressEntity
 
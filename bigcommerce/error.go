@@ -1,9 +1,137 @@
 package bigcommerce
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
 )
 
+var (
+	// ErrUnauthorized is matched by errors of requests failing with 401 Unauthorized.
+	ErrUnauthorized = errors.New("bigcommerce: unauthorized")
+	// ErrForbidden is matched by errors of requests failing with 403 Forbidden.
+	ErrForbidden = errors.New("bigcommerce: forbidden")
+	// ErrNotFound is matched by errors of requests failing with 404 Not Found.
+	ErrNotFound = errors.New("bigcommerce: not found")
+	// ErrConflict is matched by errors of requests failing with 409 Conflict.
+	ErrConflict = errors.New("bigcommerce: conflict")
+	// ErrRateLimited is matched by errors of requests failing with 429 Too Many Requests.
+	ErrRateLimited = errors.New("bigcommerce: rate limited")
+)
+
+// Error describes a request that failed with a non 2XX status.
+// It matches the sentinel errors (ErrNotFound, ErrUnauthorized, ...) of its
+// status with errors.Is and unwraps to the decoded APIError or V3APIError.
+type Error struct {
+	Status     string
+	StatusCode int
+	Method     string
+	URL        string
+	Body       []byte
+	// APIError is the decoded error response of a V2 request, if any.
+	APIError APIError
+	// V3APIError is the decoded error response of a V3 request, if any.
+	V3APIError *V3APIError
+}
+
+// newError reads the body of the failed response and returns an *Error
+// describing it. V2 error responses are JSON arrays while V3 error responses
+// are JSON objects.
+// Caller is responsible for closing the resp.Body.
+func newError(resp *http.Response) error {
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	e := &Error{
+		Status:     resp.Status,
+		StatusCode: resp.StatusCode,
+		Body:       body,
+	}
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		e.URL = resp.Request.URL.String()
+	}
+	if strings.Contains(resp.Header.Get("Content-Type"), "application/json") {
+		// Bodies not matching the error structure are only kept raw.
+		if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+			json.Unmarshal(trimmed, &e.APIError)
+		} else {
+			v3APIError := new(V3APIError)
+			if json.Unmarshal(body, v3APIError) == nil && !v3APIError.Empty() {
+				e.V3APIError = v3APIError
+			}
+		}
+	}
+	return e
+}
+
+func (e *Error) Error() string {
+	if !e.APIError.Empty() {
+		return e.APIError.Error()
+	}
+	if e.V3APIError != nil {
+		return e.V3APIError.Error()
+	}
+	return fmt.Sprintf("bigcommerce: %v", e.Status)
+}
+
+// Messages returns the messages of the decoded error response.
+func (e *Error) Messages() []string {
+	var messages []string
+	for _, apiError := range e.APIError {
+		messages = append(messages, apiError.Message)
+	}
+	if e.V3APIError != nil {
+		messages = append(messages, e.V3APIError.Title)
+		if e.V3APIError.Detail != "" {
+			messages = append(messages, e.V3APIError.Detail)
+		}
+		fields := make([]string, 0, len(e.V3APIError.Errors))
+		for field := range e.V3APIError.Errors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			messages = append(messages, fmt.Sprintf("%v: %v", field, e.V3APIError.Errors[field]))
+		}
+	}
+	return messages
+}
+
+// Is returns true if target is the sentinel error matching the status of e.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// Unwrap returns the decoded APIError or V3APIError, if any.
+func (e *Error) Unwrap() error {
+	if !e.APIError.Empty() {
+		return e.APIError
+	}
+	if e.V3APIError != nil {
+		return *e.V3APIError
+	}
+	return nil
+}
+
 // APIError describes the api error response structure.
 type APIError []struct {
 	Status  int    `json:"status"`
@@ -39,15 +167,24 @@ func (e APIError) Empty() bool {
 	return false
 }
 
-// relevantError returns any non-nil http-related error (creating the request,
-// getting the response, decoding) if any. If the decoded apiError is non-zero
-// the apiError is returned. Otherwise, no errors occurred, returns nil.
-func relevantError(httpError error, apiError APIError) error {
-	if httpError != nil {
-		return httpError
-	}
-	if apiError.Empty() {
-		return nil
+// V3APIError describes the api error response structure of the V3 API.
+type V3APIError struct {
+	Status int               `json:"status"`
+	Title  string            `json:"title"`
+	Type   string            `json:"type"`
+	Detail string            `json:"detail"`
+	Errors map[string]string `json:"errors"`
+}
+
+func (e V3APIError) Error() string {
+	if e.Empty() {
+		return ""
 	}
-	return apiError
+	return fmt.Sprintf("bigcommerce: %d %v", e.Status, e.Title)
+}
+
+// Empty returns true if empty. Otherwise, a status or title is present and
+// false is returned.
+func (e V3APIError) Empty() bool {
+	return e.Status == 0 && e.Title == ""
 }
@@ -0,0 +1,126 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_V2(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `[{
+  "status": 409,
+  "message": "The product is out of stock.",
+  "details": {
+    "errors": [{
+      "type": "InsufficientInventory",
+      "product": { "id": 12, "name": "Shoe", "inventory_level": 0 }
+    }]
+  }
+}]`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, _, err := client.Orders.New(context.Background(), &OrderBody{})
+	assert.EqualError(t, err, "bigcommerce: 409 The product is out of stock.")
+	assert.True(t, errors.Is(err, ErrConflict))
+	assert.False(t, errors.Is(err, ErrNotFound))
+
+	var bcErr *Error
+	assert.True(t, errors.As(err, &bcErr))
+	assert.Equal(t, http.StatusConflict, bcErr.StatusCode)
+	assert.Equal(t, "POST", bcErr.Method)
+	assert.Contains(t, bcErr.URL, "example.com/api/v2/orders/")
+	assert.Contains(t, string(bcErr.Body), "InsufficientInventory")
+	assert.Equal(t, []string{"The product is out of stock."}, bcErr.Messages())
+
+	var apiError APIError
+	assert.True(t, errors.As(err, &apiError))
+	assert.Equal(t, 12, apiError[0].Details.Errors[0].Product.ID)
+}
+
+func TestError_V3(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{
+  "status": 422,
+  "title": "JSON data is missing or invalid",
+  "type": "https://developer.bigcommerce.com/api-docs/getting-started/api-status-codes",
+  "errors": { "weight": "must be a number", "name": "is required" }
+}`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	_, err := performV3POST(context.Background(), client, "catalog/products", nil, struct{}{}, nil)
+	assert.EqualError(t, err, "bigcommerce: 422 JSON data is missing or invalid")
+
+	var bcErr *Error
+	assert.True(t, errors.As(err, &bcErr))
+	assert.Equal(t, []string{
+		"JSON data is missing or invalid",
+		"name: is required",
+		"weight: must be a number",
+	}, bcErr.Messages())
+
+	var v3APIError V3APIError
+	assert.True(t, errors.As(err, &v3APIError))
+	assert.Equal(t, "must be a number", v3APIError.Errors["weight"])
+}
+
+func TestError_Sentinels(t *testing.T) {
+	for status, sentinel := range map[int]error{
+		http.StatusUnauthorized:    ErrUnauthorized,
+		http.StatusForbidden:       ErrForbidden,
+		http.StatusNotFound:        ErrNotFound,
+		http.StatusConflict:        ErrConflict,
+		http.StatusTooManyRequests: ErrRateLimited,
+	} {
+		err := &Error{StatusCode: status}
+		assert.True(t, errors.Is(err, sentinel), "status %d", status)
+		assert.False(t, errors.Is(&Error{StatusCode: http.StatusBadRequest}, sentinel))
+	}
+}
+
+func TestError_NotJSON(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/123", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `<html>Not Found</html>`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, _, err := client.Orders.Show(context.Background(), 123)
+	assert.EqualError(t, err, "bigcommerce: 404 Not Found")
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	var bcErr *Error
+	assert.True(t, errors.As(err, &bcErr))
+	assert.Equal(t, "<html>Not Found</html>", string(bcErr.Body))
+	assert.Nil(t, bcErr.Messages())
+	assert.Nil(t, bcErr.Unwrap())
+}
@@ -0,0 +1,83 @@
+package bigcommerce
+
+import (
+	"context"
+)
+
+// pageFetcher fetches the given page of a List endpoint and returns the
+// number of items on it.
+type pageFetcher func(ctx context.Context, page int) (int, error)
+
+// iterator walks the pages of a List endpoint lazily. It is embedded by the
+// typed iterators of the services, which keep the items of the current page.
+type iterator struct {
+	ctx   context.Context
+	fetch pageFetcher
+	page  int
+	limit int
+	index int
+	count int
+	done  bool
+	err   error
+}
+
+func newIterator(ctx context.Context, page int, limit int, fetch pageFetcher) iterator {
+	if page < 1 {
+		page = 1
+	}
+	return iterator{
+		ctx:   ctx,
+		fetch: fetch,
+		page:  page,
+		limit: limit,
+		index: -1,
+	}
+}
+
+// next advances to the next item and fetches the next page once the items of
+// the current page are consumed. It returns false when an empty page is
+// reached or an error occurred.
+func (it *iterator) next() bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	for it.index >= it.count {
+		if it.done {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		count, err := it.fetch(it.ctx, it.page)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page++
+		it.index = 0
+		it.count = count
+		// A page with fewer items than the limit is the last page.
+		if count == 0 || (it.limit > 0 && count < it.limit) {
+			it.done = true
+		}
+	}
+	return true
+}
+
+// paginate marks the current page as the last page when the Pagination of a
+// V3 response says so. It is called by page fetchers of V3 List endpoints.
+func (it *iterator) paginate(response *Response) {
+	if response == nil || response.Pagination == nil {
+		return
+	}
+	if response.Pagination.CurrentPage >= response.Pagination.TotalPages {
+		it.done = true
+	}
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *iterator) Err() error {
+	return it.err
+}
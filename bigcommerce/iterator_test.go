@@ -0,0 +1,86 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterator_StopsOnEmptyPage(t *testing.T) {
+	var pages []int
+	it := newIterator(context.Background(), 0, 0, func(ctx context.Context, page int) (int, error) {
+		pages = append(pages, page)
+		if page < 3 {
+			return 2, nil
+		}
+		return 0, nil
+	})
+	items := 0
+	for it.next() {
+		items++
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, 4, items)
+	assert.Equal(t, []int{1, 2, 3}, pages)
+}
+
+func TestIterator_StopsOnPartialPage(t *testing.T) {
+	var pages []int
+	it := newIterator(context.Background(), 2, 5, func(ctx context.Context, page int) (int, error) {
+		pages = append(pages, page)
+		if page == 2 {
+			return 5, nil
+		}
+		return 3, nil
+	})
+	items := 0
+	for it.next() {
+		items++
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, 8, items)
+	assert.Equal(t, []int{2, 3}, pages)
+}
+
+func TestIterator_StopsOnLastV3Page(t *testing.T) {
+	var pages []int
+	it := &iterator{}
+	*it = newIterator(context.Background(), 1, 0, func(ctx context.Context, page int) (int, error) {
+		pages = append(pages, page)
+		it.paginate(&Response{Pagination: &Pagination{CurrentPage: page, TotalPages: 2}})
+		return 1, nil
+	})
+	items := 0
+	for it.next() {
+		items++
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, 2, items)
+	assert.Equal(t, []int{1, 2}, pages)
+}
+
+func TestIterator_StopsOnError(t *testing.T) {
+	it := newIterator(context.Background(), 1, 0, func(ctx context.Context, page int) (int, error) {
+		if page == 1 {
+			return 1, nil
+		}
+		return 0, errors.New(BadRequestErrorMessage)
+	})
+	assert.True(t, it.next())
+	assert.False(t, it.next())
+	assert.EqualError(t, it.Err(), BadRequestErrorMessage)
+	assert.False(t, it.next())
+}
+
+func TestIterator_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	it := newIterator(ctx, 1, 0, func(ctx context.Context, page int) (int, error) {
+		return 1, nil
+	})
+	assert.True(t, it.next())
+	cancel()
+	assert.False(t, it.next())
+	assert.Equal(t, context.Canceled, it.Err())
+}
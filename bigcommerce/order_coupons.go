@@ -0,0 +1,94 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// CouponType identifies how the discount of a coupon is calculated.
+type CouponType int
+
+// Coupon types supported by Bigcommerce.
+const (
+	CouponTypePerItemDiscount    CouponType = 0
+	CouponTypePercentageDiscount CouponType = 1
+	CouponTypePerTotalDiscount   CouponType = 2
+	CouponTypeShippingDiscount   CouponType = 3
+	CouponTypeFreeShipping       CouponType = 4
+	CouponTypePromotion          CouponType = 5
+)
+
+// OrderCoupon describes a coupon applied to an order.
+// Amount is the configured value of the coupon and Discount the amount deducted from the order.
+type OrderCoupon struct {
+	ID       int        `json:"id"`
+	CouponID int        `json:"coupon_id"`
+	OrderID  int        `json:"order_id"`
+	Code     string     `json:"code"`
+	Amount   float64    `json:"amount,string"`
+	Type     CouponType `json:"type"`
+	Discount float64    `json:"discount"`
+}
+
+// OrderCouponService adds the APIs for the coupons of an Order.
+type OrderCouponService struct {
+	client *Client
+}
+
+func newOrderCouponService(client *Client) *OrderCouponService {
+	return &OrderCouponService{
+		client: client,
+	}
+}
+
+// OrderCouponListParams are the parameters for OrderCouponService.List
+type OrderCouponListParams struct {
+	Page  int `url:"page,omitempty"`
+	Limit int `url:"limit,omitempty"`
+}
+
+// List returns a list of OrderCoupons matching the given OrderCouponListParams.
+func (s *OrderCouponService) List(ctx context.Context, orderID int, params *OrderCouponListParams) ([]OrderCoupon, *Response, error) {
+	var coupons []OrderCoupon
+
+	response, err := performGET(ctx, s.client, s.servicePath(orderID), params, &coupons)
+
+	return coupons, response, err
+}
+
+// OrderCouponIterator iterates over the OrderCoupons of all pages.
+type OrderCouponIterator struct {
+	iterator
+	coupons []OrderCoupon
+}
+
+// Next advances the iterator to the next OrderCoupon. It returns false once all pages are consumed or an error occurred.
+func (it *OrderCouponIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current OrderCoupon. It is only valid after Next returned true.
+func (it *OrderCouponIterator) Value() OrderCoupon {
+	return it.coupons[it.index]
+}
+
+// ListAll returns an OrderCouponIterator over the OrderCoupons of all pages for the given Order.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *OrderCouponService) ListAll(ctx context.Context, orderID int, params *OrderCouponListParams) *OrderCouponIterator {
+	var p OrderCouponListParams
+	if params != nil {
+		p = *params
+	}
+	it := &OrderCouponIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		coupons, _, err := s.List(ctx, orderID, &p)
+		it.coupons = coupons
+		return len(coupons), err
+	})
+	return it
+}
+
+func (s *OrderCouponService) servicePath(orderID int) string {
+	return fmt.Sprintf("orders/%d/coupons/", orderID)
+}
@@ -0,0 +1,131 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderCouponService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/coupons/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 123 }]`)
+	})
+
+	expected := []OrderCoupon{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderCouponListParams{
+		Page: 1,
+	}
+	coupons, _, err := client.OrderCoupons.List(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, coupons)
+}
+
+func TestOrderCouponService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/coupons/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderCouponListParams{
+		Page: 1,
+	}
+	coupons, _, err := client.OrderCoupons.List(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(coupons) == 0)
+}
+
+func TestOrderCouponService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/coupons/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var coupons []OrderCoupon
+	it := client.OrderCoupons.ListAll(context.Background(), 12, nil)
+	for it.Next() {
+		coupons = append(coupons, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []OrderCoupon{{ID: 1}, {ID: 2}, {ID: 3}}, coupons)
+}
+
+func TestOrderCouponService_ListReply(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/coupons/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+  {
+    "id": 1,
+    "coupon_id": 5,
+    "order_id": 12,
+    "code": "SHOES",
+    "amount": "5.0000",
+    "type": 1,
+    "discount": 3.95
+  }
+]`)
+	})
+
+	expected := []OrderCoupon{
+		{
+			ID:       1,
+			CouponID: 5,
+			OrderID:  12,
+			Code:     "SHOES",
+			Amount:   5,
+			Type:     CouponTypePercentageDiscount,
+			Discount: 3.95,
+		},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	coupons, _, err := client.OrderCoupons.List(context.Background(), 12, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, coupons)
+}
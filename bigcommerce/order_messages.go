@@ -0,0 +1,88 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// OrderMessage describes a message exchanged between the store and the customer of an order.
+type OrderMessage struct {
+	ID          int    `json:"id"`
+	OrderID     int    `json:"order_id"`
+	StaffID     int    `json:"staff_id"`
+	CustomerID  int    `json:"customer_id"`
+	Type        string `json:"type"`
+	Subject     string `json:"subject"`
+	Message     string `json:"message"`
+	Status      string `json:"status"`
+	IsFlagged   bool   `json:"is_flagged"`
+	DateCreated BCTime `json:"date_created"`
+}
+
+// OrderMessageService adds the APIs for the messages of an Order.
+type OrderMessageService struct {
+	client *Client
+}
+
+func newOrderMessageService(client *Client) *OrderMessageService {
+	return &OrderMessageService{
+		client: client,
+	}
+}
+
+// OrderMessageListParams are the parameters for OrderMessageService.List
+type OrderMessageListParams struct {
+	Page       int    `url:"page,omitempty"`
+	Limit      int    `url:"limit,omitempty"`
+	MinID      int    `url:"min_id,omitempty"`
+	MaxID      int    `url:"max_id,omitempty"`
+	CustomerID *int   `url:"customer_id,omitempty"`
+	IsFlagged  *bool  `url:"is_flagged,omitempty"`
+	Status     string `url:"status,omitempty"`
+}
+
+// List returns a list of OrderMessages matching the given OrderMessageListParams.
+func (s *OrderMessageService) List(ctx context.Context, orderID int, params *OrderMessageListParams) ([]OrderMessage, *Response, error) {
+	var messages []OrderMessage
+
+	response, err := performGET(ctx, s.client, s.servicePath(orderID), params, &messages)
+
+	return messages, response, err
+}
+
+// OrderMessageIterator iterates over the OrderMessages of all pages.
+type OrderMessageIterator struct {
+	iterator
+	messages []OrderMessage
+}
+
+// Next advances the iterator to the next OrderMessage. It returns false once all pages are consumed or an error occurred.
+func (it *OrderMessageIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current OrderMessage. It is only valid after Next returned true.
+func (it *OrderMessageIterator) Value() OrderMessage {
+	return it.messages[it.index]
+}
+
+// ListAll returns an OrderMessageIterator over the OrderMessages of all pages for the given Order.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *OrderMessageService) ListAll(ctx context.Context, orderID int, params *OrderMessageListParams) *OrderMessageIterator {
+	var p OrderMessageListParams
+	if params != nil {
+		p = *params
+	}
+	it := &OrderMessageIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		messages, _, err := s.List(ctx, orderID, &p)
+		it.messages = messages
+		return len(messages), err
+	})
+	return it
+}
+
+func (s *OrderMessageService) servicePath(orderID int) string {
+	return fmt.Sprintf("orders/%d/messages/", orderID)
+}
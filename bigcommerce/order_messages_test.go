@@ -0,0 +1,134 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderMessageService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/messages/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 123 }]`)
+	})
+
+	expected := []OrderMessage{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderMessageListParams{
+		Page: 1,
+	}
+	messages, _, err := client.OrderMessages.List(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, messages)
+}
+
+func TestOrderMessageService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/messages/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderMessageListParams{
+		Page: 1,
+	}
+	messages, _, err := client.OrderMessages.List(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(messages) == 0)
+}
+
+func TestOrderMessageService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/messages/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var messages []OrderMessage
+	it := client.OrderMessages.ListAll(context.Background(), 12, nil)
+	for it.Next() {
+		messages = append(messages, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []OrderMessage{{ID: 1}, {ID: 2}, {ID: 3}}, messages)
+}
+
+func TestOrderMessageService_ListWithFilters(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/messages/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"customer_id": "3", "is_flagged": "true", "status": "unread"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+  {
+    "id": 1,
+    "order_id": 12,
+    "staff_id": 0,
+    "customer_id": 3,
+    "type": "customer",
+    "subject": "Delivery",
+    "message": "Please leave the parcel at the door.",
+    "status": "unread",
+    "is_flagged": true,
+    "date_created": "Tue, 20 Nov 2012 22:03:04 +0000"
+  }
+]`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	customerID := 3
+	isFlagged := true
+	params := &OrderMessageListParams{
+		CustomerID: &customerID,
+		IsFlagged:  &isFlagged,
+		Status:     "unread",
+	}
+	messages, _, err := client.OrderMessages.List(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, "Please leave the parcel at the door.", messages[0].Message)
+	assert.True(t, messages[0].IsFlagged)
+	assert.Equal(t, 2012, messages[0].DateCreated.Time().Year())
+}
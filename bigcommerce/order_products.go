@@ -0,0 +1,147 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// OrderLineItem describes a product of an order as returned by the order products resource.
+type OrderLineItem struct {
+	ID               int                     `json:"id"`
+	OrderID          int                     `json:"order_id"`
+	ProductID        int                     `json:"product_id"`
+	VariantID        int                     `json:"variant_id"`
+	OrderAddressID   int                     `json:"order_address_id"`
+	Name             string                  `json:"name"`
+	Sku              string                  `json:"sku"`
+	Upc              string                  `json:"upc"`
+	Type             string                  `json:"type"`
+	BasePrice        float64                 `json:"base_price,string"`
+	PriceExTax       float64                 `json:"price_ex_tax,string"`
+	PriceIncTax      float64                 `json:"price_inc_tax,string"`
+	PriceTax         float64                 `json:"price_tax,string"`
+	BaseTotal        float64                 `json:"base_total,string"`
+	TotalExTax       float64                 `json:"total_ex_tax,string"`
+	TotalIncTax      float64                 `json:"total_inc_tax,string"`
+	TotalTax         float64                 `json:"total_tax,string"`
+	BaseCostPrice    float64                 `json:"base_cost_price,string"`
+	CostPriceExTax   float64                 `json:"cost_price_ex_tax,string"`
+	CostPriceIncTax  float64                 `json:"cost_price_inc_tax,string"`
+	CostPriceTax     float64                 `json:"cost_price_tax,string"`
+	Weight           float64                 `json:"weight,string"`
+	Quantity         int                     `json:"quantity"`
+	QuantityShipped  int                     `json:"quantity_shipped"`
+	QuantityRefunded int                     `json:"quantity_refunded"`
+	IsRefunded       bool                    `json:"is_refunded"`
+	RefundAmount     float64                 `json:"refund_amount,string"`
+	ProductOptions   []OrderLineItemOption   `json:"product_options"`
+	AppliedDiscounts []OrderLineItemDiscount `json:"applied_discounts"`
+}
+
+// OrderLineItemOption describes an option chosen for a product of an order.
+type OrderLineItemOption struct {
+	ID              int    `json:"id"`
+	OptionID        int    `json:"option_id"`
+	OrderProductID  int    `json:"order_product_id"`
+	ProductOptionID int    `json:"product_option_id"`
+	DisplayName     string `json:"display_name"`
+	DisplayValue    string `json:"display_value"`
+	Value           string `json:"value"`
+	Type            string `json:"type"`
+	Name            string `json:"name"`
+	DisplayStyle    string `json:"display_style"`
+}
+
+// OrderLineItemDiscount describes a discount applied to a product of an order.
+// The ID is a number for coupons and a string such as "total-discount" otherwise.
+type OrderLineItemDiscount struct {
+	ID     interface{} `json:"id"`
+	Amount float64     `json:"amount,string"`
+	Name   string      `json:"name"`
+	Code   string      `json:"code"`
+	Target string      `json:"target"`
+}
+
+// OrderProductService adds the APIs for the products of an Order.
+type OrderProductService struct {
+	client *Client
+}
+
+func newOrderProductService(client *Client) *OrderProductService {
+	return &OrderProductService{
+		client: client,
+	}
+}
+
+// OrderProductListParams are the parameters for OrderProductService.List
+type OrderProductListParams struct {
+	Page  int `url:"page,omitempty"`
+	Limit int `url:"limit,omitempty"`
+}
+
+// List returns a list of OrderLineItems matching the given OrderProductListParams.
+func (s *OrderProductService) List(ctx context.Context, orderID int, params *OrderProductListParams) ([]OrderLineItem, *Response, error) {
+	var items []OrderLineItem
+
+	response, err := performGET(ctx, s.client, s.servicePath(orderID), params, &items)
+
+	return items, response, err
+}
+
+// OrderLineItemIterator iterates over the OrderLineItems of all pages.
+type OrderLineItemIterator struct {
+	iterator
+	items []OrderLineItem
+}
+
+// Next advances the iterator to the next OrderLineItem. It returns false once all pages are consumed or an error occurred.
+func (it *OrderLineItemIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current OrderLineItem. It is only valid after Next returned true.
+func (it *OrderLineItemIterator) Value() OrderLineItem {
+	return it.items[it.index]
+}
+
+// ListAll returns an OrderLineItemIterator over the OrderLineItems of all pages for the given Order.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *OrderProductService) ListAll(ctx context.Context, orderID int, params *OrderProductListParams) *OrderLineItemIterator {
+	var p OrderProductListParams
+	if params != nil {
+		p = *params
+	}
+	it := &OrderLineItemIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		items, _, err := s.List(ctx, orderID, &p)
+		it.items = items
+		return len(items), err
+	})
+	return it
+}
+
+// Count returns the number of products of the given Order.
+func (s *OrderProductService) Count(ctx context.Context, orderID int, params *OrderProductListParams) (int, *Response, error) {
+	var cnt count
+
+	path := strings.Join([]string{s.servicePath(orderID), "count"}, "")
+	response, err := performGET(ctx, s.client, path, params, &cnt)
+
+	return cnt.Count, response, err
+}
+
+// Show returns the requested OrderLineItem.
+func (s *OrderProductService) Show(ctx context.Context, orderID int, id int) (*OrderLineItem, *Response, error) {
+	item := new(OrderLineItem)
+
+	path := fmt.Sprintf("%v%v", s.servicePath(orderID), id)
+	response, err := performGET(ctx, s.client, path, nil, &item)
+
+	return item, response, err
+}
+
+func (s *OrderProductService) servicePath(orderID int) string {
+	return fmt.Sprintf("orders/%d/products/", orderID)
+}
@@ -0,0 +1,292 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderProductService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/products/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 123 }]`)
+	})
+
+	expected := []OrderLineItem{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderProductListParams{
+		Page: 1,
+	}
+	items, _, err := client.OrderProducts.List(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, items)
+}
+
+func TestOrderProductService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/products/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderProductListParams{
+		Page: 1,
+	}
+	items, _, err := client.OrderProducts.List(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(items) == 0)
+}
+
+func TestOrderProductService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/products/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var items []OrderLineItem
+	it := client.OrderProducts.ListAll(context.Background(), 12, nil)
+	for it.Next() {
+		items = append(items, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []OrderLineItem{{ID: 1}, {ID: 2}, {ID: 3}}, items)
+}
+
+func TestOrderProductService_Count(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/products/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "count": 12 }`)
+	})
+
+	expected := 12
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderProductListParams{
+		Limit: 10,
+	}
+	count, _, err := client.OrderProducts.Count(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, count)
+}
+
+func TestOrderProductService_CountWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/products/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderProductListParams{
+		Limit: 10,
+	}
+	_, _, err := client.OrderProducts.Count(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestOrderProductService_Show(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/products/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &OrderLineItem{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	item, _, err := client.OrderProducts.Show(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, item)
+}
+
+func TestOrderProductService_ShowWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/products/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, _, err := client.OrderProducts.Show(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestOrderProductService_ShowReply(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/products/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+    "id": 3,
+    "order_id": 12,
+    "product_id": 77,
+    "variant_id": 112,
+    "order_address_id": 9,
+    "name": "Fog Linen Chambray Towel - Beige Stripe",
+    "sku": "SLCTBS-A9615DD0",
+    "type": "physical",
+    "base_price": "49.0000",
+    "price_ex_tax": "45.0000",
+    "price_inc_tax": "49.0000",
+    "price_tax": "4.0000",
+    "base_total": "98.0000",
+    "total_ex_tax": "90.0000",
+    "total_inc_tax": "98.0000",
+    "total_tax": "8.0000",
+    "weight": "1.0000",
+    "quantity": 2,
+    "base_cost_price": "0.0000",
+    "cost_price_inc_tax": "0.0000",
+    "cost_price_ex_tax": "0.0000",
+    "cost_price_tax": "0.0000",
+    "is_refunded": false,
+    "quantity_refunded": 0,
+    "refund_amount": "0.0000",
+    "quantity_shipped": 1,
+    "upc": "",
+    "product_options": [
+      {
+        "id": 5,
+        "option_id": 18,
+        "order_product_id": 3,
+        "product_option_id": 108,
+        "display_name": "Color",
+        "display_value": "Beige",
+        "value": "69",
+        "type": "Swatch",
+        "name": "Color1521469428-77",
+        "display_style": ""
+      }
+    ],
+    "applied_discounts": [
+      {
+        "id": "total-discount",
+        "amount": "1.6000",
+        "name": "Total Discount",
+        "code": null,
+        "target": "order"
+      },
+      {
+        "id": 1,
+        "amount": "3.2000",
+        "name": "Coupon Discount",
+        "code": "SAVE5",
+        "target": "order"
+      }
+    ]
+  }`)
+	})
+
+	expected := &OrderLineItem{
+		ID:              3,
+		OrderID:         12,
+		ProductID:       77,
+		VariantID:       112,
+		OrderAddressID:  9,
+		Name:            "Fog Linen Chambray Towel - Beige Stripe",
+		Sku:             "SLCTBS-A9615DD0",
+		Type:            "physical",
+		BasePrice:       49,
+		PriceExTax:      45,
+		PriceIncTax:     49,
+		PriceTax:        4,
+		BaseTotal:       98,
+		TotalExTax:      90,
+		TotalIncTax:     98,
+		TotalTax:        8,
+		Weight:          1,
+		Quantity:        2,
+		QuantityShipped: 1,
+		ProductOptions: []OrderLineItemOption{
+			{
+				ID:              5,
+				OptionID:        18,
+				OrderProductID:  3,
+				ProductOptionID: 108,
+				DisplayName:     "Color",
+				DisplayValue:    "Beige",
+				Value:           "69",
+				Type:            "Swatch",
+				Name:            "Color1521469428-77",
+			},
+		},
+		AppliedDiscounts: []OrderLineItemDiscount{
+			{ID: "total-discount", Amount: 1.6, Name: "Total Discount", Target: "order"},
+			{ID: float64(1), Amount: 3.2, Name: "Coupon Discount", Code: "SAVE5", Target: "order"},
+		},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	item, _, err := client.OrderProducts.Show(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, item)
+}
@@ -0,0 +1,199 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ShippingProvider identifies the shipping provider of an OrderShipment.
+type ShippingProvider string
+
+// Shipping providers supported by Bigcommerce. ShippingProviderCustom is used
+// for shipments without a provider integration.
+const (
+	ShippingProviderCustom        ShippingProvider = ""
+	ShippingProviderAustraliaPost ShippingProvider = "auspost"
+	ShippingProviderCanadaPost    ShippingProvider = "canadapost"
+	ShippingProviderEndicia       ShippingProvider = "endicia"
+	ShippingProviderUSPS          ShippingProvider = "usps"
+	ShippingProviderFedEx         ShippingProvider = "fedex"
+	ShippingProviderUPS           ShippingProvider = "ups"
+	ShippingProviderUPSReady      ShippingProvider = "upsready"
+	ShippingProviderUPSOnline     ShippingProvider = "upsonline"
+	ShippingProviderShipperHQ     ShippingProvider = "shipperhq"
+)
+
+// TrackingCarrier identifies the carrier used to build the tracking link of an
+// OrderShipment. Any carrier slug supported by AfterShip is accepted.
+type TrackingCarrier string
+
+// Common tracking carriers.
+const (
+	TrackingCarrierNone          TrackingCarrier = ""
+	TrackingCarrierAustraliaPost TrackingCarrier = "australia-post"
+	TrackingCarrierCanadaPost    TrackingCarrier = "canada-post"
+	TrackingCarrierDHL           TrackingCarrier = "dhl"
+	TrackingCarrierFedEx         TrackingCarrier = "fedex"
+	TrackingCarrierRoyalMail     TrackingCarrier = "royal-mail"
+	TrackingCarrierUPS           TrackingCarrier = "ups"
+	TrackingCarrierUSPS          TrackingCarrier = "usps"
+)
+
+// OrderShipment describes the shipment resource of an order.
+// OrderAddressID refers to the ID of an OrderShippingAddress of the order.
+type OrderShipment struct {
+	ID                   int                 `json:"id"`
+	OrderID              int                 `json:"order_id"`
+	CustomerID           int                 `json:"customer_id"`
+	OrderAddressID       int                 `json:"order_address_id"`
+	DateCreated          BCTime              `json:"date_created"`
+	TrackingNumber       string              `json:"tracking_number"`
+	MerchantShippingCost float64             `json:"merchant_shipping_cost,string"`
+	ShippingMethod       string              `json:"shipping_method"`
+	Comments             string              `json:"comments"`
+	ShippingProvider     ShippingProvider    `json:"shipping_provider"`
+	TrackingCarrier      TrackingCarrier     `json:"tracking_carrier"`
+	TrackingLink         string              `json:"tracking_link"`
+	BillingAddress       AddressEntity       `json:"billing_address"`
+	ShippingAddress      AddressEntity       `json:"shipping_address"`
+	Items                []OrderShipmentItem `json:"items"`
+}
+
+// OrderShipmentItem describes a product included in an OrderShipment.
+// OrderProductID refers to the ID of an OrderLineItem of the order.
+type OrderShipmentItem struct {
+	OrderProductID int `json:"order_product_id"`
+	ProductID      int `json:"product_id,omitempty"`
+	Quantity       int `json:"quantity"`
+}
+
+// OrderShipmentService adds the APIs for the shipments of an Order.
+type OrderShipmentService struct {
+	client *Client
+}
+
+func newOrderShipmentService(client *Client) *OrderShipmentService {
+	return &OrderShipmentService{
+		client: client,
+	}
+}
+
+// OrderShipmentListParams are the parameters for OrderShipmentService.List
+type OrderShipmentListParams struct {
+	Page  int `url:"page,omitempty"`
+	Limit int `url:"limit,omitempty"`
+}
+
+// List returns a list of OrderShipments matching the given OrderShipmentListParams.
+func (s *OrderShipmentService) List(ctx context.Context, orderID int, params *OrderShipmentListParams) ([]OrderShipment, *Response, error) {
+	var shipments []OrderShipment
+
+	response, err := performGET(ctx, s.client, s.servicePath(orderID), params, &shipments)
+
+	return shipments, response, err
+}
+
+// OrderShipmentIterator iterates over the OrderShipments of all pages.
+type OrderShipmentIterator struct {
+	iterator
+	shipments []OrderShipment
+}
+
+// Next advances the iterator to the next OrderShipment. It returns false once all pages are consumed or an error occurred.
+func (it *OrderShipmentIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current OrderShipment. It is only valid after Next returned true.
+func (it *OrderShipmentIterator) Value() OrderShipment {
+	return it.shipments[it.index]
+}
+
+// ListAll returns an OrderShipmentIterator over the OrderShipments of all pages for the given Order.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *OrderShipmentService) ListAll(ctx context.Context, orderID int, params *OrderShipmentListParams) *OrderShipmentIterator {
+	var p OrderShipmentListParams
+	if params != nil {
+		p = *params
+	}
+	it := &OrderShipmentIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		shipments, _, err := s.List(ctx, orderID, &p)
+		it.shipments = shipments
+		return len(shipments), err
+	})
+	return it
+}
+
+// Count returns the number of shipments of the given Order.
+func (s *OrderShipmentService) Count(ctx context.Context, orderID int, params *OrderShipmentListParams) (int, *Response, error) {
+	var cnt count
+
+	path := strings.Join([]string{s.servicePath(orderID), "count"}, "")
+	response, err := performGET(ctx, s.client, path, params, &cnt)
+
+	return cnt.Count, response, err
+}
+
+// Show returns the requested OrderShipment.
+func (s *OrderShipmentService) Show(ctx context.Context, orderID int, id int) (*OrderShipment, *Response, error) {
+	shipment := new(OrderShipment)
+
+	path := fmt.Sprintf("%v%v", s.servicePath(orderID), id)
+	response, err := performGET(ctx, s.client, path, nil, &shipment)
+
+	return shipment, response, err
+}
+
+// OrderShipmentBody describes the shipment information given when creating a new OrderShipment.
+// OrderAddressID and Items are required.
+type OrderShipmentBody struct {
+	OrderAddressID   int                 `json:"order_address_id"`
+	TrackingNumber   string              `json:"tracking_number,omitempty"`
+	ShippingMethod   string              `json:"shipping_method,omitempty"`
+	ShippingProvider ShippingProvider    `json:"shipping_provider,omitempty"`
+	TrackingCarrier  TrackingCarrier     `json:"tracking_carrier,omitempty"`
+	Comments         string              `json:"comments,omitempty"`
+	Items            []OrderShipmentItem `json:"items"`
+}
+
+// New creates a new OrderShipment for the given Order and returns it.
+func (s *OrderShipmentService) New(ctx context.Context, orderID int, body *OrderShipmentBody) (*OrderShipment, *Response, error) {
+	shipment := new(OrderShipment)
+
+	response, err := performPOST(ctx, s.client, s.servicePath(orderID), nil, body, shipment)
+
+	return shipment, response, err
+}
+
+// OrderShipmentEditParams describes the fields that are editable on an OrderShipment.
+type OrderShipmentEditParams struct {
+	OrderAddressID   *int             `json:"order_address_id,omitempty"`
+	TrackingNumber   string           `json:"tracking_number,omitempty"`
+	ShippingMethod   string           `json:"shipping_method,omitempty"`
+	ShippingProvider ShippingProvider `json:"shipping_provider,omitempty"`
+	TrackingCarrier  TrackingCarrier  `json:"tracking_carrier,omitempty"`
+	Comments         string           `json:"comments,omitempty"`
+}
+
+// Edit updates the given OrderShipmentEditParams of the given OrderShipment.
+func (s *OrderShipmentService) Edit(ctx context.Context, orderID int, id int, body *OrderShipmentEditParams) (*OrderShipment, *Response, error) {
+	shipment := new(OrderShipment)
+
+	path := fmt.Sprintf("%v%v", s.servicePath(orderID), id)
+	response, err := performPUT(ctx, s.client, path, nil, body, shipment)
+
+	return shipment, response, err
+}
+
+// Delete deletes the given OrderShipment.
+func (s *OrderShipmentService) Delete(ctx context.Context, orderID int, id int) (*Response, error) {
+	path := fmt.Sprintf("%v%v", s.servicePath(orderID), id)
+	return performDELETE(ctx, s.client, path, nil)
+}
+
+func (s *OrderShipmentService) servicePath(orderID int) string {
+	return fmt.Sprintf("orders/%d/shipments/", orderID)
+}
@@ -0,0 +1,375 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderShipmentService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 123 }]`)
+	})
+
+	expected := []OrderShipment{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderShipmentListParams{
+		Page: 1,
+	}
+	shipments, _, err := client.OrderShipments.List(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, shipments)
+}
+
+func TestOrderShipmentService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderShipmentListParams{
+		Page: 1,
+	}
+	shipments, _, err := client.OrderShipments.List(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(shipments) == 0)
+}
+
+func TestOrderShipmentService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var shipments []OrderShipment
+	it := client.OrderShipments.ListAll(context.Background(), 12, nil)
+	for it.Next() {
+		shipments = append(shipments, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []OrderShipment{{ID: 1}, {ID: 2}, {ID: 3}}, shipments)
+}
+
+func TestOrderShipmentService_Count(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "count": 12 }`)
+	})
+
+	expected := 12
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderShipmentListParams{
+		Limit: 10,
+	}
+	count, _, err := client.OrderShipments.Count(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, count)
+}
+
+func TestOrderShipmentService_CountWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderShipmentListParams{
+		Limit: 10,
+	}
+	_, _, err := client.OrderShipments.Count(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestOrderShipmentService_Show(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &OrderShipment{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	shipment, _, err := client.OrderShipments.Show(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, shipment)
+}
+
+func TestOrderShipmentService_ShowWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, _, err := client.OrderShipments.Show(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestOrderShipmentService_New(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &OrderShipment{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &OrderShipmentBody{
+		OrderAddressID:   4,
+		TrackingNumber:   "1Z999AA10123456784",
+		ShippingProvider: ShippingProviderUPS,
+		TrackingCarrier:  TrackingCarrierUPS,
+		Items: []OrderShipmentItem{
+			{OrderProductID: 16, Quantity: 1},
+		},
+	}
+	shipment, _, err := client.OrderShipments.New(context.Background(), 12, body)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, shipment)
+}
+
+func TestOrderShipmentService_NewWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &OrderShipmentBody{
+		OrderAddressID:   4,
+		TrackingNumber:   "1Z999AA10123456784",
+		ShippingProvider: ShippingProviderUPS,
+		TrackingCarrier:  TrackingCarrierUPS,
+		Items: []OrderShipmentItem{
+			{OrderProductID: 16, Quantity: 1},
+		},
+	}
+	_, _, err := client.OrderShipments.New(context.Background(), 12, body)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestOrderShipmentService_Edit(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &OrderShipment{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderShipmentEditParams{
+		TrackingNumber: "1Z999AA10123456784",
+	}
+	shipment, _, err := client.OrderShipments.Edit(context.Background(), 12, 3, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, shipment)
+}
+
+func TestOrderShipmentService_EditWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderShipmentEditParams{
+		TrackingNumber: "1Z999AA10123456784",
+	}
+	_, _, err := client.OrderShipments.Edit(context.Background(), 12, 3, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestOrderShipmentService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	response, err := client.OrderShipments.Delete(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestOrderShipmentService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, err := client.OrderShipments.Delete(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestOrderShipmentService_NewBody(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipments/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{
+  "order_address_id": 4,
+  "tracking_number": "1Z999AA10123456784",
+  "shipping_provider": "ups",
+  "tracking_carrier": "ups",
+  "items": [{ "order_product_id": 16, "quantity": 1 }]
+}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "id": 1,
+  "order_id": 12,
+  "customer_id": 3,
+  "order_address_id": 4,
+  "date_created": "Tue, 20 Nov 2012 22:03:04 +0000",
+  "tracking_number": "1Z999AA10123456784",
+  "merchant_shipping_cost": "0.0000",
+  "shipping_method": "Free Shipping",
+  "comments": "",
+  "shipping_provider": "ups",
+  "tracking_carrier": "ups",
+  "tracking_link": "https://www.ups.com/track?tracknum=1Z999AA10123456784",
+  "billing_address": { "first_name": "Trisha", "last_name": "McLaughlin" },
+  "shipping_address": { "first_name": "Trisha", "last_name": "McLaughlin" },
+  "items": [{ "order_product_id": 16, "product_id": 0, "quantity": 1 }]
+}`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &OrderShipmentBody{
+		OrderAddressID:   4,
+		TrackingNumber:   "1Z999AA10123456784",
+		ShippingProvider: ShippingProviderUPS,
+		TrackingCarrier:  TrackingCarrierUPS,
+		Items: []OrderShipmentItem{
+			{OrderProductID: 16, Quantity: 1},
+		},
+	}
+	shipment, _, err := client.OrderShipments.New(context.Background(), 12, body)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, shipment.OrderAddressID)
+	assert.Equal(t, ShippingProviderUPS, shipment.ShippingProvider)
+	assert.Equal(t, TrackingCarrierUPS, shipment.TrackingCarrier)
+	assert.Equal(t, "Trisha", shipment.ShippingAddress.FirstName)
+	assert.Equal(t, []OrderShipmentItem{{OrderProductID: 16, Quantity: 1}}, shipment.Items)
+	assert.Equal(t, 2012, shipment.DateCreated.Time().Year())
+}
@@ -3,7 +3,6 @@ package bigcommerce
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strings"
 )
 
@@ -17,14 +16,12 @@ type OrderShippingAddress struct {
 
 // OrderShippingAddressService adds the APIs for the OrderShippingAddress resource.
 type OrderShippingAddressService struct {
-	config     *ClientConfig
-	httpClient *http.Client
+	client *Client
 }
 
-func newOrderShippingAddressService(config *ClientConfig, httpClient *http.Client) *OrderShippingAddressService {
+func newOrderShippingAddressService(client *Client) *OrderShippingAddressService {
 	return &OrderShippingAddressService{
-		config:     config,
-		httpClient: httpClient,
+		client: client,
 	}
 }
 
@@ -35,35 +32,71 @@ type OrderShippingAddressListParams struct {
 }
 
 // List returns a list of OrderShippingAddresses matching the given OrderShippingAddressListParams.
-func (s *OrderShippingAddressService) List(ctx context.Context, orderID int, params *OrderShippingAddressListParams) ([]OrderShippingAddress, *http.Response, error) {
+func (s *OrderShippingAddressService) List(ctx context.Context, orderID int, params *OrderShippingAddressListParams) ([]OrderShippingAddress, *Response, error) {
 	var osa []OrderShippingAddress
-	var apiError APIError
 
-	response, err := performGET(ctx, s.httpClient, s.config, s.servicePath(orderID), params, &osa, &apiError)
+	response, err := performGET(ctx, s.client, s.servicePath(orderID), params, &osa)
 
-	return osa, response, relevantError(err, apiError)
+	return osa, response, err
+}
+
+// OrderShippingAddressIterator iterates over the OrderShippingAddresses of all pages.
+type OrderShippingAddressIterator struct {
+	iterator
+	orderShippingAddresses []OrderShippingAddress
+}
+
+// Next advances the iterator to the next OrderShippingAddress. It returns false once all pages are consumed or an error occurred.
+func (it *OrderShippingAddressIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current OrderShippingAddress. It is only valid after Next returned true.
+func (it *OrderShippingAddressIterator) Value() OrderShippingAddress {
+	return it.orderShippingAddresses[it.index]
+}
+
+// ListAll returns an OrderShippingAddressIterator over the OrderShippingAddresses of all pages for the given Order.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *OrderShippingAddressService) ListAll(ctx context.Context, orderID int, params *OrderShippingAddressListParams) *OrderShippingAddressIterator {
+	var p OrderShippingAddressListParams
+	if params != nil {
+		p = *params
+	}
+	it := &OrderShippingAddressIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		orderShippingAddresses, _, err := s.List(ctx, orderID, &p)
+		it.orderShippingAddresses = orderShippingAddresses
+		return len(orderShippingAddresses), err
+	})
+	return it
 }
 
 // Count returns an OrderShippingAddressCount for OrderShippingAddresses that matches the given OrderShippingAddressListParams.
-func (s *OrderShippingAddressService) Count(ctx context.Context, orderID int, params *OrderShippingAddressListParams) (int, *http.Response, error) {
+func (s *OrderShippingAddressService) Count(ctx context.Context, orderID int, params *OrderShippingAddressListParams) (int, *Response, error) {
 	var cnt count
-	var apiError APIError
 
 	path := strings.Join([]string{s.servicePath(orderID), "count"}, "")
-	response, err := performGET(ctx, s.httpClient, s.config, path, params, &cnt, &apiError)
+	response, err := performGET(ctx, s.client, path, params, &cnt)
 
-	return cnt.Count, response, relevantError(err, apiError)
+	return cnt.Count, response, err
 }
 
 // Show returns the requested OrderShippingAddress.
-func (s *OrderShippingAddressService) Show(ctx context.Context, orderID int, id int) (*OrderShippingAddress, *http.Response, error) {
+func (s *OrderShippingAddressService) Show(ctx context.Context, orderID int, id int) (*OrderShippingAddress, *Response, error) {
 	osa := new(OrderShippingAddress)
-	var apiError APIError
 
 	path := fmt.Sprintf("%v%v", s.servicePath(orderID), id)
-	response, err := performGET(ctx, s.httpClient, s.config, path, nil, &osa, &apiError)
+	response, err := performGET(ctx, s.client, path, nil, &osa)
 
-	return osa, response, relevantError(err, apiError)
+	return osa, response, err
+}
+
+// Delete deletes the given OrderShippingAddress.
+func (s *OrderShippingAddressService) Delete(ctx context.Context, orderID int, id int) (*Response, error) {
+	path := fmt.Sprintf("%v%v", s.servicePath(orderID), id)
+	return performDELETE(ctx, s.client, path, nil)
 }
 
 func (s *OrderShippingAddressService) servicePath(orderID int) string {
@@ -59,6 +59,55 @@ func TestOrderShippingAddressService_ListWithError(t *testing.T) {
 	assert.True(t, len(orderShippingAddresses) == 0)
 }
 
+func TestOrderShippingAddressService_ListNoContent(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipping_addresses/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	orderShippingAddresses, _, err := client.OrderShippingAddresses.List(context.Background(), 12, &OrderShippingAddressListParams{})
+	assert.Nil(t, err)
+	assert.True(t, len(orderShippingAddresses) == 0)
+}
+
+func TestOrderShippingAddressService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipping_addresses/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var orderShippingAddresses []OrderShippingAddress
+	it := client.OrderShippingAddresses.ListAll(context.Background(), 12, nil)
+	for it.Next() {
+		orderShippingAddresses = append(orderShippingAddresses, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []OrderShippingAddress{{ID: 1}, {ID: 2}, {ID: 3}}, orderShippingAddresses)
+}
+
 func TestOrderShippingAddressService_Count(t *testing.T) {
 	httpClient, mux, server := testServer()
 	defer server.Close()
@@ -147,3 +196,40 @@ func TestOrderShippingAddressService_ShowWithError(t *testing.T) {
 	_, _, err := client.OrderShippingAddresses.Show(context.Background(), 12, 3)
 	assert.EqualError(t, err, BadRequestErrorMessage)
 }
+
+func TestOrderShippingAddressService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipping_addresses/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	response, err := client.OrderShippingAddresses.Delete(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestOrderShippingAddressService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/shipping_addresses/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, err := client.OrderShippingAddresses.Delete(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
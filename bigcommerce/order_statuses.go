@@ -3,7 +3,6 @@ package bigcommerce
 import (
 	"context"
 	"fmt"
-	"net/http"
 )
 
 const orderStatusServicePath = "order_statuses/"
@@ -17,14 +16,12 @@ type OrderStatus struct {
 
 // OrderStatusService adds the APIs for the Product resource.
 type OrderStatusService struct {
-	config     *ClientConfig
-	httpClient *http.Client
+	client *Client
 }
 
-func newOrderStatusService(config *ClientConfig, httpClient *http.Client) *OrderStatusService {
+func newOrderStatusService(client *Client) *OrderStatusService {
 	return &OrderStatusService{
-		config:     config,
-		httpClient: httpClient,
+		client: client,
 	}
 }
 
@@ -35,22 +32,53 @@ type OrderStatusListParams struct {
 }
 
 // List returns a list of Products matching the given ProductListParams.
-func (s *OrderStatusService) List(ctx context.Context, params *OrderStatusListParams) ([]OrderStatus, *http.Response, error) {
+func (s *OrderStatusService) List(ctx context.Context, params *OrderStatusListParams) ([]OrderStatus, *Response, error) {
 	var os []OrderStatus
-	var apiError APIError
 
-	response, err := performGET(ctx, s.httpClient, s.config, orderStatusServicePath, params, &os, &apiError)
+	response, err := performGET(ctx, s.client, orderStatusServicePath, params, &os)
 
-	return os, response, relevantError(err, apiError)
+	return os, response, err
+}
+
+// OrderStatusIterator iterates over the OrderStatuses of all pages.
+type OrderStatusIterator struct {
+	iterator
+	orderStatuses []OrderStatus
+}
+
+// Next advances the iterator to the next OrderStatus. It returns false once all pages are consumed or an error occurred.
+func (it *OrderStatusIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current OrderStatus. It is only valid after Next returned true.
+func (it *OrderStatusIterator) Value() OrderStatus {
+	return it.orderStatuses[it.index]
+}
+
+// ListAll returns an OrderStatusIterator over the OrderStatuses of all pages.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *OrderStatusService) ListAll(ctx context.Context, params *OrderStatusListParams) *OrderStatusIterator {
+	var p OrderStatusListParams
+	if params != nil {
+		p = *params
+	}
+	it := &OrderStatusIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		orderStatuses, _, err := s.List(ctx, &p)
+		it.orderStatuses = orderStatuses
+		return len(orderStatuses), err
+	})
+	return it
 }
 
 // Show returns the requested OrderStatus.
-func (s *OrderStatusService) Show(ctx context.Context, id int) (*OrderStatus, *http.Response, error) {
+func (s *OrderStatusService) Show(ctx context.Context, id int) (*OrderStatus, *Response, error) {
 	orderStatus := new(OrderStatus)
-	var apiError APIError
 
 	path := fmt.Sprintf("%v%v", orderStatusServicePath, id)
-	response, err := performGET(ctx, s.httpClient, s.config, path, nil, &orderStatus, &apiError)
+	response, err := performGET(ctx, s.client, path, nil, &orderStatus)
 
-	return orderStatus, response, relevantError(err, apiError)
+	return orderStatus, response, err
 }
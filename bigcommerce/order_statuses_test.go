@@ -58,6 +58,55 @@ func TestOrderStatusService_ListWithError(t *testing.T) {
 	assert.True(t, len(orderStatuses) == 0)
 }
 
+func TestOrderStatusService_ListNoContent(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/order_statuses/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	orderStatuses, _, err := client.OrderStatuses.List(context.Background(), &OrderStatusListParams{})
+	assert.Nil(t, err)
+	assert.True(t, len(orderStatuses) == 0)
+}
+
+func TestOrderStatusService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/order_statuses/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var orderStatuses []OrderStatus
+	it := client.OrderStatuses.ListAll(context.Background(), nil)
+	for it.Next() {
+		orderStatuses = append(orderStatuses, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []OrderStatus{{ID: 1}, {ID: 2}, {ID: 3}}, orderStatuses)
+}
+
 func TestOrderStatusService_Show(t *testing.T) {
 	httpClient, mux, server := testServer()
 	defer server.Close()
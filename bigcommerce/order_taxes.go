@@ -0,0 +1,86 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// OrderTax describes a tax line of an order.
+type OrderTax struct {
+	ID             int     `json:"id"`
+	OrderID        int     `json:"order_id"`
+	OrderAddressID int     `json:"order_address_id"`
+	OrderProductID int     `json:"order_product_id"`
+	TaxRateID      int     `json:"tax_rate_id"`
+	TaxClassID     int     `json:"tax_class_id"`
+	Name           string  `json:"name"`
+	Class          string  `json:"class"`
+	Rate           float64 `json:"rate,string"`
+	Priority       int     `json:"priority"`
+	PriorityAmount float64 `json:"priority_amount,string"`
+	LineAmount     float64 `json:"line_amount,string"`
+	LineItemType   string  `json:"line_item_type"`
+}
+
+// OrderTaxService adds the APIs for the taxes of an Order.
+type OrderTaxService struct {
+	client *Client
+}
+
+func newOrderTaxService(client *Client) *OrderTaxService {
+	return &OrderTaxService{
+		client: client,
+	}
+}
+
+// OrderTaxListParams are the parameters for OrderTaxService.List
+type OrderTaxListParams struct {
+	Page  int `url:"page,omitempty"`
+	Limit int `url:"limit,omitempty"`
+}
+
+// List returns a list of OrderTaxes matching the given OrderTaxListParams.
+func (s *OrderTaxService) List(ctx context.Context, orderID int, params *OrderTaxListParams) ([]OrderTax, *Response, error) {
+	var taxes []OrderTax
+
+	response, err := performGET(ctx, s.client, s.servicePath(orderID), params, &taxes)
+
+	return taxes, response, err
+}
+
+// OrderTaxIterator iterates over the OrderTaxes of all pages.
+type OrderTaxIterator struct {
+	iterator
+	taxes []OrderTax
+}
+
+// Next advances the iterator to the next OrderTax. It returns false once all pages are consumed or an error occurred.
+func (it *OrderTaxIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current OrderTax. It is only valid after Next returned true.
+func (it *OrderTaxIterator) Value() OrderTax {
+	return it.taxes[it.index]
+}
+
+// ListAll returns an OrderTaxIterator over the OrderTaxes of all pages for the given Order.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *OrderTaxService) ListAll(ctx context.Context, orderID int, params *OrderTaxListParams) *OrderTaxIterator {
+	var p OrderTaxListParams
+	if params != nil {
+		p = *params
+	}
+	it := &OrderTaxIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		taxes, _, err := s.List(ctx, orderID, &p)
+		it.taxes = taxes
+		return len(taxes), err
+	})
+	return it
+}
+
+func (s *OrderTaxService) servicePath(orderID int) string {
+	return fmt.Sprintf("orders/%d/taxes/", orderID)
+}
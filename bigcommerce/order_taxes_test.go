@@ -0,0 +1,141 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderTaxService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/taxes/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 123 }]`)
+	})
+
+	expected := []OrderTax{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderTaxListParams{
+		Page: 1,
+	}
+	taxes, _, err := client.OrderTaxes.List(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, taxes)
+}
+
+func TestOrderTaxService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/taxes/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &OrderTaxListParams{
+		Page: 1,
+	}
+	taxes, _, err := client.OrderTaxes.List(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(taxes) == 0)
+}
+
+func TestOrderTaxService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/taxes/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var taxes []OrderTax
+	it := client.OrderTaxes.ListAll(context.Background(), 12, nil)
+	for it.Next() {
+		taxes = append(taxes, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []OrderTax{{ID: 1}, {ID: 2}, {ID: 3}}, taxes)
+}
+
+func TestOrderTaxService_ListReply(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/12/taxes/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+  {
+    "id": 1,
+    "order_id": 12,
+    "order_address_id": 4,
+    "tax_rate_id": 1,
+    "tax_class_id": 0,
+    "name": "Tax",
+    "class": "Default Tax Class",
+    "rate": "8.5000",
+    "priority": 0,
+    "priority_amount": "6.7150",
+    "line_amount": "6.7150",
+    "order_product_id": 16,
+    "line_item_type": "item"
+  }
+]`)
+	})
+
+	expected := []OrderTax{
+		{
+			ID:             1,
+			OrderID:        12,
+			OrderAddressID: 4,
+			OrderProductID: 16,
+			TaxRateID:      1,
+			Name:           "Tax",
+			Class:          "Default Tax Class",
+			Rate:           8.5,
+			PriorityAmount: 6.715,
+			LineAmount:     6.715,
+			LineItemType:   "item",
+		},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	taxes, _, err := client.OrderTaxes.List(context.Background(), 12, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, taxes)
+}
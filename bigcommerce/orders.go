@@ -3,8 +3,8 @@ package bigcommerce
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strings"
+	"time"
 )
 
 const orderServicePath = "orders/"
@@ -40,68 +40,118 @@ type Order struct {
 	StaffNotes           string        `json:"staff_notes"`
 	CustomerMessage      string        `json:"customer_message"`
 	DiscountAmount       string        `json:"discount_amount"`
-	CouponDiscount       string        `json:"counpon_discount"`
+	CouponDiscount       float64       `json:"coupon_discount,string"`
 	ShippingAddressCount int           `json:"shipping_address_count"`
 	BillingAddress       AddressEntity `json:"billing_address"`
 }
 
 // OrderService adds the APIs for the Order resource.
 type OrderService struct {
-	config     *ClientConfig
-	httpClient *http.Client
+	client *Client
 }
 
-func newOrderService(config *ClientConfig, httpClient *http.Client) *OrderService {
+func newOrderService(client *Client) *OrderService {
 	return &OrderService{
-		config:     config,
-		httpClient: httpClient,
+		client: client,
 	}
 }
 
+// OrderSort defines the sort order of OrderService.List
+type OrderSort string
+
+// Sort orders supported by OrderService.List
+const (
+	OrderSortIDAsc            OrderSort = "id:asc"
+	OrderSortIDDesc           OrderSort = "id:desc"
+	OrderSortCustomerIDAsc    OrderSort = "customer_id:asc"
+	OrderSortCustomerIDDesc   OrderSort = "customer_id:desc"
+	OrderSortDateCreatedAsc   OrderSort = "date_created:asc"
+	OrderSortDateCreatedDesc  OrderSort = "date_created:desc"
+	OrderSortDateModifiedAsc  OrderSort = "date_modified:asc"
+	OrderSortDateModifiedDesc OrderSort = "date_modified:desc"
+	OrderSortStatusIDAsc      OrderSort = "status_id:asc"
+	OrderSortStatusIDDesc     OrderSort = "status_id:desc"
+)
+
 // OrderListParams are the parameters for OrderService.List
+// The date filters are sent in RFC 2822 format and are left out when zero.
 type OrderListParams struct {
-	Page          int     `url:"page,omitempty"`
-	Limit         int     `url:"limit,omitempty"`
-	Sort          string  `url:"sort,omitempty"`
-	MinID         int     `url:"min_id,omitempty"`
-	MaxID         int     `url:"max_id,omitempty"`
-	MinTotal      float64 `url:"min_total,omitempty"`
-	MaxTotal      float64 `url:"max_total,omitempty"`
-	CustomerID    *int    `url:"customer_id,omitempty"`
-	Email         string  `url:"email,omitempty"`
-	StatusID      *int    `url:"status_id,omitempty"`
-	PaymentMethod string  `url:"payment_method,omitempty"`
-	//TODO: add date and boolean based params.
+	Page            int       `url:"page,omitempty"`
+	Limit           int       `url:"limit,omitempty"`
+	Sort            OrderSort `url:"sort,omitempty"`
+	MinID           int       `url:"min_id,omitempty"`
+	MaxID           int       `url:"max_id,omitempty"`
+	MinTotal        float64   `url:"min_total,omitempty"`
+	MaxTotal        float64   `url:"max_total,omitempty"`
+	CustomerID      *int      `url:"customer_id,omitempty"`
+	Email           string    `url:"email,omitempty"`
+	StatusID        *int      `url:"status_id,omitempty"`
+	PaymentMethod   string    `url:"payment_method,omitempty"`
+	MinDateCreated  time.Time `url:"min_date_created,omitempty"`
+	MaxDateCreated  time.Time `url:"max_date_created,omitempty"`
+	MinDateModified time.Time `url:"min_date_modified,omitempty"`
+	MaxDateModified time.Time `url:"max_date_modified,omitempty"`
+	IsDeleted       *bool     `url:"is_deleted,omitempty"`
 }
 
 // List returns a list of Orders matching the given OrderListParams.
-func (s *OrderService) List(ctx context.Context, params *OrderListParams) ([]Order, *http.Response, error) {
+func (s *OrderService) List(ctx context.Context, params *OrderListParams) ([]Order, *Response, error) {
 	var orders []Order
-	var apiError APIError
-	response, err := performGET(ctx, s.httpClient, s.config, orderServicePath, params, &orders, &apiError)
-	return orders, response, relevantError(err, apiError)
+	response, err := performGET(ctx, s.client, orderServicePath, params, &orders)
+	return orders, response, err
+}
+
+// OrderIterator iterates over the Orders of all pages matching the given OrderListParams.
+type OrderIterator struct {
+	iterator
+	orders []Order
+}
+
+// Next advances the iterator to the next Order. It returns false once all pages are consumed or an error occurred.
+func (it *OrderIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current Order. It is only valid after Next returned true.
+func (it *OrderIterator) Value() Order {
+	return it.orders[it.index]
+}
+
+// ListAll returns an OrderIterator over the Orders of all pages matching the given OrderListParams.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *OrderService) ListAll(ctx context.Context, params *OrderListParams) *OrderIterator {
+	var p OrderListParams
+	if params != nil {
+		p = *params
+	}
+	it := &OrderIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		orders, _, err := s.List(ctx, &p)
+		it.orders = orders
+		return len(orders), err
+	})
+	return it
 }
 
 // Count returns an OrderCount for Orders that matches the given OrderListParams.
-func (s *OrderService) Count(ctx context.Context, params *OrderListParams) (int, *http.Response, error) {
+func (s *OrderService) Count(ctx context.Context, params *OrderListParams) (int, *Response, error) {
 	var cnt count
-	var apiError APIError
 
 	path := strings.Join([]string{orderServicePath, "count"}, "")
-	response, err := performGET(ctx, s.httpClient, s.config, path, params, &cnt, &apiError)
+	response, err := performGET(ctx, s.client, path, params, &cnt)
 
-	return cnt.Count, response, relevantError(err, apiError)
+	return cnt.Count, response, err
 }
 
 // Show returns the requested Order.
-func (s *OrderService) Show(ctx context.Context, id int32) (*Order, *http.Response, error) {
+func (s *OrderService) Show(ctx context.Context, id int32) (*Order, *Response, error) {
 	order := new(Order)
-	var apiError APIError
 
 	path := fmt.Sprintf("%v%v", orderServicePath, id)
-	response, err := performGET(ctx, s.httpClient, s.config, path, nil, &order, &apiError)
+	response, err := performGET(ctx, s.client, path, nil, &order)
 
-	return order, response, relevantError(err, apiError)
+	return order, response, err
 }
 
 // OrderProduct defines a product to be included in the OrderBody.
@@ -138,13 +188,12 @@ type OrderBody struct {
 }
 
 // New creates a new Order with the specified information and returns the new order.
-func (s *OrderService) New(ctx context.Context, body *OrderBody) (*Order, *http.Response, error) {
+func (s *OrderService) New(ctx context.Context, body *OrderBody) (*Order, *Response, error) {
 	order := new(Order)
-	var apiError APIError
 
-	response, err := performPOST(ctx, s.httpClient, s.config, orderServicePath, nil, body, order, &apiError)
+	response, err := performPOST(ctx, s.client, orderServicePath, nil, body, order)
 
-	return order, response, relevantError(err, apiError)
+	return order, response, err
 }
 
 // OrderEditParams describes the fields that are editable on an Order.
@@ -158,12 +207,22 @@ type OrderEditParams struct {
 }
 
 // Edit updates the given OrderEditParams of the given Order.
-func (s *OrderService) Edit(ctx context.Context, id int, body *OrderEditParams) (*Order, *http.Response, error) {
+func (s *OrderService) Edit(ctx context.Context, id int, body *OrderEditParams) (*Order, *Response, error) {
 	order := new(Order)
-	var apiError APIError
 
 	path := fmt.Sprintf("%v%v", orderServicePath, id)
-	response, err := performPUT(ctx, s.httpClient, s.config, path, nil, body, order, &apiError)
+	response, err := performPUT(ctx, s.client, path, nil, body, order)
+
+	return order, response, err
+}
+
+// Delete deletes the given Order.
+func (s *OrderService) Delete(ctx context.Context, id int) (*Response, error) {
+	path := fmt.Sprintf("%v%v", orderServicePath, id)
+	return performDELETE(ctx, s.client, path, nil)
+}
 
-	return order, response, relevantError(err, apiError)
+// DeleteAll deletes all Orders of the store.
+func (s *OrderService) DeleteAll(ctx context.Context) (*Response, error) {
+	return performDELETE(ctx, s.client, orderServicePath, nil)
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -62,6 +63,92 @@ func TestOrderService_ListWithError(t *testing.T) {
 	assert.True(t, len(orders) == 0)
 }
 
+func TestOrderService_ListWithFilters(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{
+			"sort":              "date_modified:desc",
+			"min_date_created":  "Wed, 14 Nov 2012 19:26:23 +0000",
+			"max_date_modified": "Thu, 15 Nov 2012 08:00:00 +0100",
+			"is_deleted":        "false",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 123 }]`)
+	})
+
+	expected := []Order{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	minDateCreated := time.Date(2012, time.November, 14, 19, 26, 23, 0, time.UTC)
+	maxDateModified := time.Date(2012, time.November, 15, 8, 0, 0, 0, time.FixedZone("CET", 3600))
+	isDeleted := false
+	params := &OrderListParams{
+		Sort:            OrderSortDateModifiedDesc,
+		MinDateCreated:  minDateCreated,
+		MaxDateModified: maxDateModified,
+		IsDeleted:       &isDeleted,
+	}
+	orders, _, err := client.Orders.List(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, orders)
+}
+
+func TestOrderService_ListNoContent(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	orders, _, err := client.Orders.List(context.Background(), &OrderListParams{})
+	assert.Nil(t, err)
+	assert.True(t, len(orders) == 0)
+}
+
+func TestOrderService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var orders []Order
+	it := client.Orders.ListAll(context.Background(), nil)
+	for it.Next() {
+		orders = append(orders, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []Order{{ID: 1}, {ID: 2}, {ID: 3}}, orders)
+}
+
 func TestOrderService_Count(t *testing.T) {
 	httpClient, mux, server := testServer()
 	defer server.Close()
@@ -393,7 +480,7 @@ func TestOrderService_NewReply(t *testing.T) {
     "staff_notes": "",
     "customer_message": "",
     "discount_amount": "0.0000",
-    "coupon_discount": "0.0000",
+    "coupon_discount": "5.0000",
     "shipping_address_count": 1,
     "is_deleted": false,
     "billing_address": {
@@ -425,7 +512,7 @@ func TestOrderService_NewReply(t *testing.T) {
   }`)
 	})
 
-	want := `{"id":100,"customer_id":10,"date_created":"Wed, 14 Nov 2012 19:26:23 +0000","date_modified":"Wed, 14 Nov 2012 19:26:23 +0000","date_shipped":"","status_id":11,"status":"Awaiting Fulfillment","handling_cost_ex_tax":"0","handling_cost_inc_tax":"0","handling_cost_tax":"0","shipping_cost_ex_tax":"0","shipping_cost_inc_tax":"0","shipping_cost_tax":"0","subtotal_ex_tax":"79","subtotal_inc_tax":"79","subtotal_tax":"0","total_ex_tax":"79","total_inc_tax":"79","total_tax":"0","base_shipping_cost":"0","items_total":1,"payment_method":"cash","payment_status":"","ip_address":"50.58.18.2","currency_id":1,"currency_code":"USD","staff_notes":"","customer_message":"","discount_amount":"0.0000","counpon_discount":"","shipping_address_count":1,"billing_address":{"first_name":"Trisha","last_name":"McLaughlin","company":"","street_1":"12345 W Anderson Ln","street_2":"","city":"Austin","state":"Texas","zip":"78757","country":"United States","country_iso2":"US","phone":"","email":"elsie@example.com"}}`
+	want := `{"id":100,"customer_id":10,"date_created":"Wed, 14 Nov 2012 19:26:23 +0000","date_modified":"Wed, 14 Nov 2012 19:26:23 +0000","date_shipped":"","status_id":11,"status":"Awaiting Fulfillment","handling_cost_ex_tax":"0","handling_cost_inc_tax":"0","handling_cost_tax":"0","shipping_cost_ex_tax":"0","shipping_cost_inc_tax":"0","shipping_cost_tax":"0","subtotal_ex_tax":"79","subtotal_inc_tax":"79","subtotal_tax":"0","total_ex_tax":"79","total_inc_tax":"79","total_tax":"0","base_shipping_cost":"0","items_total":1,"payment_method":"cash","payment_status":"","ip_address":"50.58.18.2","currency_id":1,"currency_code":"USD","staff_notes":"","customer_message":"","discount_amount":"0.0000","coupon_discount":"5","shipping_address_count":1,"billing_address":{"first_name":"Trisha","last_name":"McLaughlin","company":"","street_1":"12345 W Anderson Ln","street_2":"","city":"Austin","state":"Texas","zip":"78757","country":"United States","country_iso2":"US","phone":"","email":"elsie@example.com"}}`
 	client := NewClient(httpClient, &ClientConfig{
 		Endpoint: "https://example.com",
 		UserName: "go-bigcommerce",
@@ -524,3 +611,77 @@ func TestOrderService_EditWithError(t *testing.T) {
 	_, _, err := client.Orders.Edit(context.Background(), 123, params)
 	assert.EqualError(t, err, BadRequestErrorMessage)
 }
+
+func TestOrderService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/123", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	response, err := client.Orders.Delete(context.Background(), 123)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestOrderService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/123", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, err := client.Orders.Delete(context.Background(), 123)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestOrderService_DeleteAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	response, err := client.Orders.DeleteAll(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestOrderService_DeleteAllWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, err := client.Orders.DeleteAll(context.Background())
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
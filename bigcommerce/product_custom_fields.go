@@ -2,7 +2,7 @@ package bigcommerce
 
 import (
 	"fmt"
-	"net/http"
+	"strings"
 
 	"context"
 )
@@ -17,14 +17,12 @@ type ProductCustomField struct {
 
 // ProductCustomFieldService adds the APIs for the ProductCustomField resource.
 type ProductCustomFieldService struct {
-	config     *ClientConfig
-	httpClient *http.Client
+	client *Client
 }
 
-func newProductCustomFieldService(config *ClientConfig, httpClient *http.Client) *ProductCustomFieldService {
+func newProductCustomFieldService(client *Client) *ProductCustomFieldService {
 	return &ProductCustomFieldService{
-		config:     config,
-		httpClient: httpClient,
+		client: client,
 	}
 }
 
@@ -35,24 +33,125 @@ type ProductCustomFieldListParams struct {
 }
 
 // List returns a list of ProductCustomFields matching the given ProductCustomFieldListParams.
-func (s *ProductCustomFieldService) List(ctx context.Context, productID int, params *ProductCustomFieldListParams) ([]ProductCustomField, *http.Response, error) {
+func (s *ProductCustomFieldService) List(ctx context.Context, productID int, params *ProductCustomFieldListParams) ([]ProductCustomField, *Response, error) {
 	var customFields []ProductCustomField
-	var apiError APIError
 
-	response, err := performGET(ctx, s.httpClient, s.config, s.servicePath(productID), params, &customFields, &apiError)
+	response, err := performGET(ctx, s.client, s.servicePath(productID), params, &customFields)
 
-	return customFields, response, relevantError(err, apiError)
+	return customFields, response, err
+}
+
+// ProductCustomFieldIterator iterates over the ProductCustomFields of all pages.
+type ProductCustomFieldIterator struct {
+	iterator
+	customFields []ProductCustomField
+}
+
+// Next advances the iterator to the next ProductCustomField. It returns false once all pages are consumed or an error occurred.
+func (it *ProductCustomFieldIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current ProductCustomField. It is only valid after Next returned true.
+func (it *ProductCustomFieldIterator) Value() ProductCustomField {
+	return it.customFields[it.index]
+}
+
+// ListAll returns a ProductCustomFieldIterator over the ProductCustomFields of all pages for the given Product.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *ProductCustomFieldService) ListAll(ctx context.Context, productID int, params *ProductCustomFieldListParams) *ProductCustomFieldIterator {
+	var p ProductCustomFieldListParams
+	if params != nil {
+		p = *params
+	}
+	it := &ProductCustomFieldIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		customFields, _, err := s.List(ctx, productID, &p)
+		it.customFields = customFields
+		return len(customFields), err
+	})
+	return it
+}
+
+// Count returns the number of ProductCustomFields of the given Product.
+func (s *ProductCustomFieldService) Count(ctx context.Context, productID int, params *ProductCustomFieldListParams) (int, *Response, error) {
+	var cnt count
+
+	path := strings.Join([]string{s.servicePath(productID), "count"}, "/")
+	response, err := performGET(ctx, s.client, path, params, &cnt)
+
+	return cnt.Count, response, err
 }
 
 // Show returns the requested ProductCustomField.
-func (s *ProductCustomFieldService) Show(ctx context.Context, productID int, id int) (*ProductCustomField, *http.Response, error) {
+func (s *ProductCustomFieldService) Show(ctx context.Context, productID int, id int) (*ProductCustomField, *Response, error) {
+	customField := new(ProductCustomField)
+
+	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
+	response, err := performGET(ctx, s.client, path, nil, &customField)
+
+	return customField, response, err
+}
+
+// ProductCustomFieldBody describes the custom field information given when creating or editing a ProductCustomField.
+type ProductCustomFieldBody struct {
+	Name string `json:"name,omitempty"`
+	Text string `json:"text,omitempty"`
+}
+
+// New creates a new ProductCustomField on the given Product and returns the new custom field.
+func (s *ProductCustomFieldService) New(ctx context.Context, productID int, body *ProductCustomFieldBody) (*ProductCustomField, *Response, error) {
+	customField := new(ProductCustomField)
+
+	response, err := performPOST(ctx, s.client, s.servicePath(productID), nil, body, customField)
+
+	return customField, response, err
+}
+
+// Edit updates the given ProductCustomField with the given ProductCustomFieldBody.
+func (s *ProductCustomFieldService) Edit(ctx context.Context, productID int, id int, body *ProductCustomFieldBody) (*ProductCustomField, *Response, error) {
 	customField := new(ProductCustomField)
-	var apiError APIError
 
 	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
-	response, err := performGET(ctx, s.httpClient, s.config, path, nil, &customField, &apiError)
+	response, err := performPUT(ctx, s.client, path, nil, body, customField)
+
+	return customField, response, err
+}
 
-	return customField, response, relevantError(err, apiError)
+// Upsert sets the text of the ProductCustomField with the given name on the given Product.
+// The custom field is created if the product has none with that name. A custom field that
+// already holds the given text is returned as is, along with the Response of the List request
+// that found it.
+func (s *ProductCustomFieldService) Upsert(ctx context.Context, productID int, name string, text string) (*ProductCustomField, *Response, error) {
+	body := &ProductCustomFieldBody{Name: name, Text: text}
+	params := &ProductCustomFieldListParams{Page: 1, Limit: 250}
+	for {
+		customFields, response, err := s.List(ctx, productID, params)
+		if err != nil {
+			return nil, response, err
+		}
+		for _, customField := range customFields {
+			if customField.Name != name {
+				continue
+			}
+			if customField.Text == text {
+				return &customField, response, nil
+			}
+			return s.Edit(ctx, productID, customField.ID, body)
+		}
+		if len(customFields) < params.Limit {
+			break
+		}
+		params.Page++
+	}
+	return s.New(ctx, productID, body)
+}
+
+// Delete deletes the given ProductCustomField.
+func (s *ProductCustomFieldService) Delete(ctx context.Context, productID int, id int) (*Response, error) {
+	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
+	return performDELETE(ctx, s.client, path, nil)
 }
 
 func (s *ProductCustomFieldService) servicePath(productID int) string {
@@ -3,6 +3,7 @@ package bigcommerce
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 
@@ -58,6 +59,55 @@ func TestProductCustomFieldService_ListWithError(t *testing.T) {
 	assert.True(t, len(customFields) == 0)
 }
 
+func TestProductCustomFieldService_ListNoContent(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	customFields, _, err := client.ProductCustomFields.List(context.Background(), 12, &ProductCustomFieldListParams{})
+	assert.Nil(t, err)
+	assert.True(t, len(customFields) == 0)
+}
+
+func TestProductCustomFieldService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var customFields []ProductCustomField
+	it := client.ProductCustomFields.ListAll(context.Background(), 12, nil)
+	for it.Next() {
+		customFields = append(customFields, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []ProductCustomField{{ID: 1}, {ID: 2}, {ID: 3}}, customFields)
+}
+
 func TestProductCustomFieldService_Show(t *testing.T) {
 	httpClient, mux, server := testServer()
 	defer server.Close()
@@ -95,3 +145,286 @@ func TestProductCustomFieldService_ShowWithError(t *testing.T) {
 	_, _, err := client.ProductCustomFields.Show(context.Background(), 12, 3)
 	assert.EqualError(t, err, "bigcommerce: 400 Bad Request")
 }
+
+func TestProductCustomFieldService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	response, err := client.ProductCustomFields.Delete(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestProductCustomFieldService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, err := client.ProductCustomFields.Delete(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductCustomFieldService_Count(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "count": 12 }`)
+	})
+
+	expected := 12
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &ProductCustomFieldListParams{
+		Limit: 10,
+	}
+	count, _, err := client.ProductCustomFields.Count(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, count)
+}
+
+func TestProductCustomFieldService_CountWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &ProductCustomFieldListParams{
+		Limit: 10,
+	}
+	_, _, err := client.ProductCustomFields.Count(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductCustomFieldService_New(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &ProductCustomField{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &ProductCustomFieldBody{
+		Name: "Supplier",
+		Text: "ACME",
+	}
+	customField, _, err := client.ProductCustomFields.New(context.Background(), 12, body)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, customField)
+}
+
+func TestProductCustomFieldService_NewWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &ProductCustomFieldBody{
+		Name: "Supplier",
+		Text: "ACME",
+	}
+	_, _, err := client.ProductCustomFields.New(context.Background(), 12, body)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductCustomFieldService_Edit(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &ProductCustomField{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &ProductCustomFieldBody{
+		Text: "ACME Inc.",
+	}
+	customField, _, err := client.ProductCustomFields.Edit(context.Background(), 12, 3, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, customField)
+}
+
+func TestProductCustomFieldService_EditWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &ProductCustomFieldBody{
+		Text: "ACME Inc.",
+	}
+	_, _, err := client.ProductCustomFields.Edit(context.Background(), 12, 3, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductCustomFieldService_UpsertCreates(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			if r.URL.Query().Get("page") != "1" {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1, "name": "Compliance", "text": "CE" }]`)
+		case "POST":
+			body, err := ioutil.ReadAll(r.Body)
+			assert.Nil(t, err)
+			assert.JSONEq(t, `{ "name": "Supplier", "text": "ACME" }`, string(body))
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{ "id": 2, "name": "Supplier", "text": "ACME" }`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	customField, response, err := client.ProductCustomFields.Upsert(context.Background(), 12, "Supplier", "ACME")
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, &ProductCustomField{ID: 2, Name: "Supplier", Text: "ACME"}, customField)
+}
+
+func TestProductCustomFieldService_UpsertEdits(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1, "name": "Compliance", "text": "CE" }, { "id": 2, "name": "Supplier", "text": "ACME" }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	mux.HandleFunc("/api/v2/products/12/custom_fields/2", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{ "name": "Supplier", "text": "ACME Inc." }`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 2, "name": "Supplier", "text": "ACME Inc." }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	customField, _, err := client.ProductCustomFields.Upsert(context.Background(), 12, "Supplier", "ACME Inc.")
+	assert.Nil(t, err)
+	assert.Equal(t, &ProductCustomField{ID: 2, Name: "Supplier", Text: "ACME Inc."}, customField)
+}
+
+func TestProductCustomFieldService_UpsertUnchanged(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 2, "name": "Supplier", "text": "ACME" }]`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	customField, response, err := client.ProductCustomFields.Upsert(context.Background(), 12, "Supplier", "ACME")
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, "GET", response.Request.Method)
+	assert.Equal(t, &ProductCustomField{ID: 2, Name: "Supplier", Text: "ACME"}, customField)
+}
+
+func TestProductCustomFieldService_UpsertWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	customField, _, err := client.ProductCustomFields.Upsert(context.Background(), 12, "Supplier", "ACME")
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.Nil(t, customField)
+}
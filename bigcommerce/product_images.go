@@ -0,0 +1,184 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"strconv"
+	"time"
+
+	"context"
+)
+
+// ProductImage describes the product image resource.
+type ProductImage struct {
+	ID           int       `json:"id"`
+	ProductID    int       `json:"product_id"`
+	IsThumbnail  bool      `json:"is_thumbnail"`
+	SortOrder    int       `json:"sort_order"`
+	Description  string    `json:"description"`
+	ImageFile    string    `json:"image_file"`
+	URLZoom      string    `json:"url_zoom"`
+	URLStandard  string    `json:"url_standard"`
+	URLThumbnail string    `json:"url_thumbnail"`
+	URLTiny      string    `json:"url_tiny"`
+	DateModified time.Time `json:"date_modified"`
+}
+
+// ProductImageService adds the APIs for the ProductImage resource.
+// It uses the V3 catalog API, which requires a client configured with OAuth credentials.
+type ProductImageService struct {
+	client *Client
+}
+
+func newProductImageService(client *Client) *ProductImageService {
+	return &ProductImageService{
+		client: client,
+	}
+}
+
+// ProductImageListParams are the parameters for ProductImageService.List
+type ProductImageListParams struct {
+	Page  int `url:"page,omitempty"`
+	Limit int `url:"limit,omitempty"`
+}
+
+// List returns a list of ProductImages of the given Product matching the given ProductImageListParams.
+func (s *ProductImageService) List(ctx context.Context, productID int, params *ProductImageListParams) ([]ProductImage, *Response, error) {
+	var images []ProductImage
+
+	response, err := performV3GET(ctx, s.client, s.servicePath(productID), params, &images)
+
+	return images, response, err
+}
+
+// ProductImageIterator iterates over the ProductImages of all pages.
+type ProductImageIterator struct {
+	iterator
+	images []ProductImage
+}
+
+// Next advances the iterator to the next ProductImage. It returns false once all pages are consumed or an error occurred.
+func (it *ProductImageIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current ProductImage. It is only valid after Next returned true.
+func (it *ProductImageIterator) Value() ProductImage {
+	return it.images[it.index]
+}
+
+// ListAll returns a ProductImageIterator over the ProductImages of all pages for the given Product.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *ProductImageService) ListAll(ctx context.Context, productID int, params *ProductImageListParams) *ProductImageIterator {
+	var p ProductImageListParams
+	if params != nil {
+		p = *params
+	}
+	it := &ProductImageIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		images, response, err := s.List(ctx, productID, &p)
+		it.images = images
+		it.paginate(response)
+		return len(images), err
+	})
+	return it
+}
+
+// Show returns the requested ProductImage.
+func (s *ProductImageService) Show(ctx context.Context, productID int, id int) (*ProductImage, *Response, error) {
+	image := new(ProductImage)
+
+	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
+	response, err := performV3GET(ctx, s.client, path, nil, image)
+
+	return image, response, err
+}
+
+// ProductImageBody describes the image information given when creating a ProductImage from the image at ImageURL.
+type ProductImageBody struct {
+	ImageURL    string `json:"image_url"`
+	IsThumbnail bool   `json:"is_thumbnail,omitempty"`
+	SortOrder   int    `json:"sort_order,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// New creates a new ProductImage for the given Product from the image at body.ImageURL and returns the new image.
+func (s *ProductImageService) New(ctx context.Context, productID int, body *ProductImageBody) (*ProductImage, *Response, error) {
+	image := new(ProductImage)
+
+	response, err := performV3POST(ctx, s.client, s.servicePath(productID), nil, body, image)
+
+	return image, response, err
+}
+
+// ProductImageUpload describes an image file uploaded as multipart/form-data when creating a ProductImage.
+// File is read completely before the request is sent.
+type ProductImageUpload struct {
+	FileName    string
+	File        io.Reader
+	IsThumbnail bool
+	SortOrder   int
+	Description string
+}
+
+// EncodeBody encodes the ProductImageUpload as multipart/form-data.
+// It fails if the ProductImageUpload or its File is nil.
+func (u *ProductImageUpload) EncodeBody() (string, []byte, error) {
+	if u == nil {
+		return "", nil, errors.New("bigcommerce: ProductImageUpload is nil")
+	}
+	if u.File == nil {
+		return "", nil, errors.New("bigcommerce: ProductImageUpload.File is nil")
+	}
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	if u.IsThumbnail {
+		if err := w.WriteField("is_thumbnail", "true"); err != nil {
+			return "", nil, err
+		}
+	}
+	if u.SortOrder != 0 {
+		if err := w.WriteField("sort_order", strconv.Itoa(u.SortOrder)); err != nil {
+			return "", nil, err
+		}
+	}
+	if u.Description != "" {
+		if err := w.WriteField("description", u.Description); err != nil {
+			return "", nil, err
+		}
+	}
+	part, err := w.CreateFormFile("image_file", u.FileName)
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err := io.Copy(part, u.File); err != nil {
+		return "", nil, err
+	}
+	if err := w.Close(); err != nil {
+		return "", nil, err
+	}
+	return w.FormDataContentType(), buf.Bytes(), nil
+}
+
+// Upload creates a new ProductImage for the given Product from the uploaded image file and returns the new image.
+func (s *ProductImageService) Upload(ctx context.Context, productID int, upload *ProductImageUpload) (*ProductImage, *Response, error) {
+	image := new(ProductImage)
+
+	response, err := performV3POST(ctx, s.client, s.servicePath(productID), nil, upload, image)
+
+	return image, response, err
+}
+
+// Delete deletes the given ProductImage.
+func (s *ProductImageService) Delete(ctx context.Context, productID int, id int) (*Response, error) {
+	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
+	return performV3DELETE(ctx, s.client, path, nil)
+}
+
+func (s *ProductImageService) servicePath(productID int) string {
+	return fmt.Sprintf("catalog/products/%d/images", productID)
+}
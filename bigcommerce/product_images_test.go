@@ -0,0 +1,283 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductImageService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/images", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": [{ "id": 123 }] }`)
+	})
+
+	expected := []ProductImage{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductImageListParams{
+		Page: 1,
+	}
+	images, _, err := client.ProductImages.List(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, images)
+}
+
+func TestProductImageService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/images", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductImageListParams{
+		Page: 1,
+	}
+	images, _, err := client.ProductImages.List(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(images) == 0)
+}
+
+func TestProductImageService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/images", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{ "data": [{ "id": 1 }, { "id": 2 }], "meta": { "pagination": { "total": 3, "current_page": 1, "total_pages": 2 } } }`)
+		default:
+			fmt.Fprint(w, `{ "data": [{ "id": 3 }], "meta": { "pagination": { "total": 3, "current_page": 2, "total_pages": 2 } } }`)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	var images []ProductImage
+	it := client.ProductImages.ListAll(context.Background(), 12, nil)
+	for it.Next() {
+		images = append(images, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []ProductImage{{ID: 1}, {ID: 2}, {ID: 3}}, images)
+}
+
+func TestProductImageService_Show(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/images/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 123 } }`)
+	})
+
+	expected := &ProductImage{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	image, _, err := client.ProductImages.Show(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, image)
+}
+
+func TestProductImageService_ShowWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/images/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	_, _, err := client.ProductImages.Show(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductImageService_New(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/images", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 123 } }`)
+	})
+
+	expected := &ProductImage{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	body := &ProductImageBody{
+		ImageURL:    "https://example.com/shirt.jpg",
+		IsThumbnail: true,
+	}
+	image, _, err := client.ProductImages.New(context.Background(), 12, body)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, image)
+}
+
+func TestProductImageService_NewWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/images", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	body := &ProductImageBody{
+		ImageURL:    "https://example.com/shirt.jpg",
+		IsThumbnail: true,
+	}
+	_, _, err := client.ProductImages.New(context.Background(), 12, body)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductImageService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/images/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	response, err := client.ProductImages.Delete(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestProductImageService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/images/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	_, err := client.ProductImages.Delete(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductImageService_Upload(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/images", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assert.Nil(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "true", r.FormValue("is_thumbnail"))
+		assert.Equal(t, "2", r.FormValue("sort_order"))
+		file, header, err := r.FormFile("image_file")
+		assert.Nil(t, err)
+		assert.Equal(t, "shirt.jpg", header.Filename)
+		data, err := ioutil.ReadAll(file)
+		assert.Nil(t, err)
+		assert.Equal(t, "image data", string(data))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 123, "product_id": 12, "is_thumbnail": true, "date_modified": "2018-08-15T14:49:05+00:00" } }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	upload := &ProductImageUpload{
+		FileName:    "shirt.jpg",
+		File:        strings.NewReader("image data"),
+		IsThumbnail: true,
+		SortOrder:   2,
+	}
+	image, _, err := client.ProductImages.Upload(context.Background(), 12, upload)
+	assert.Nil(t, err)
+	assert.Equal(t, 123, image.ID)
+	assert.True(t, image.IsThumbnail)
+	assert.Equal(t, 2018, image.DateModified.Year())
+}
+
+func TestProductImageService_UploadWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/images", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	upload := &ProductImageUpload{
+		FileName: "shirt.jpg",
+		File:     strings.NewReader("image data"),
+	}
+	_, _, err := client.ProductImages.Upload(context.Background(), 12, upload)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductImageService_UploadNil(t *testing.T) {
+	client := NewClient(http.DefaultClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	_, _, err := client.ProductImages.Upload(context.Background(), 12, nil)
+	assert.EqualError(t, err, "bigcommerce: ProductImageUpload is nil")
+	_, _, err = client.ProductImages.Upload(context.Background(), 12, &ProductImageUpload{FileName: "shirt.jpg"})
+	assert.EqualError(t, err, "bigcommerce: ProductImageUpload.File is nil")
+}
@@ -0,0 +1,198 @@
+package bigcommerce
+
+import (
+	"fmt"
+
+	"context"
+)
+
+// AdjusterType defines how a modifier adjusts the price or weight of a product.
+type AdjusterType string
+
+// Adjuster types supported by Bigcommerce.
+const (
+	AdjusterTypeRelative   AdjusterType = "relative"
+	AdjusterTypePercentage AdjusterType = "percentage"
+)
+
+// ProductModifier describes the product modifier resource. Unlike options,
+// modifiers do not create variants but may adjust price and weight.
+type ProductModifier struct {
+	ID           int                    `json:"id"`
+	ProductID    int                    `json:"product_id"`
+	Name         string                 `json:"name"`
+	DisplayName  string                 `json:"display_name"`
+	Type         OptionType             `json:"type"`
+	Required     bool                   `json:"required"`
+	SortOrder    int                    `json:"sort_order"`
+	Config       ProductModifierConfig  `json:"config"`
+	OptionValues []ProductModifierValue `json:"option_values"`
+}
+
+// ProductModifierConfig describes the type specific configuration of a ProductModifier.
+type ProductModifierConfig struct {
+	DefaultValue          string   `json:"default_value,omitempty"`
+	CheckedByDefault      bool     `json:"checked_by_default,omitempty"`
+	CheckboxLabel         string   `json:"checkbox_label,omitempty"`
+	DateLimited           bool     `json:"date_limited,omitempty"`
+	DateLimitMode         string   `json:"date_limit_mode,omitempty"`
+	DateEarliestValue     string   `json:"date_earliest_value,omitempty"`
+	DateLatestValue       string   `json:"date_latest_value,omitempty"`
+	FileTypesMode         string   `json:"file_types_mode,omitempty"`
+	FileTypesSupported    []string `json:"file_types_supported,omitempty"`
+	FileTypesOther        []string `json:"file_types_other,omitempty"`
+	FileMaxSize           int      `json:"file_max_size,omitempty"`
+	TextCharactersLimited bool     `json:"text_characters_limited,omitempty"`
+	TextMinLength         int      `json:"text_min_length,omitempty"`
+	TextMaxLength         int      `json:"text_max_length,omitempty"`
+	TextLinesLimited      bool     `json:"text_lines_limited,omitempty"`
+	TextMaxLines          int      `json:"text_max_lines,omitempty"`
+	NumberLimited         bool     `json:"number_limited,omitempty"`
+	NumberLimitMode       string   `json:"number_limit_mode,omitempty"`
+	NumberLowestValue     float64  `json:"number_lowest_value,omitempty"`
+	NumberHighestValue    float64  `json:"number_highest_value,omitempty"`
+	NumberIntegersOnly    bool     `json:"number_integers_only,omitempty"`
+}
+
+// ProductModifierValue describes a value of a ProductModifier.
+type ProductModifierValue struct {
+	ID        int                `json:"id,omitempty"`
+	OptionID  int                `json:"option_id,omitempty"`
+	Label     string             `json:"label"`
+	SortOrder int                `json:"sort_order"`
+	IsDefault bool               `json:"is_default"`
+	ValueData *OptionValueData   `json:"value_data,omitempty"`
+	Adjusters *ModifierAdjusters `json:"adjusters,omitempty"`
+}
+
+// ModifierAdjusters describes how selecting a ProductModifierValue changes the product.
+type ModifierAdjusters struct {
+	Price              *ModifierAdjuster           `json:"price,omitempty"`
+	Weight             *ModifierAdjuster           `json:"weight,omitempty"`
+	ImageURL           string                      `json:"image_url,omitempty"`
+	PurchasingDisabled *ModifierPurchasingDisabled `json:"purchasing_disabled,omitempty"`
+}
+
+// ModifierAdjuster describes a price or weight adjustment. The AdjusterValue is
+// added to the price or weight, or applied as percentage for AdjusterTypePercentage.
+type ModifierAdjuster struct {
+	Adjuster      AdjusterType `json:"adjuster"`
+	AdjusterValue float64      `json:"adjuster_value"`
+}
+
+// ModifierPurchasingDisabled describes whether selecting a ProductModifierValue disables purchasing.
+type ModifierPurchasingDisabled struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// ProductModifierService adds the APIs for the ProductModifier resource.
+// It uses the V3 catalog API, which requires a client configured with OAuth credentials.
+type ProductModifierService struct {
+	client *Client
+}
+
+func newProductModifierService(client *Client) *ProductModifierService {
+	return &ProductModifierService{
+		client: client,
+	}
+}
+
+// ProductModifierListParams are the parameters for ProductModifierService.List
+type ProductModifierListParams struct {
+	Page  int `url:"page,omitempty"`
+	Limit int `url:"limit,omitempty"`
+}
+
+// List returns a list of ProductModifiers of the given Product matching the given ProductModifierListParams.
+func (s *ProductModifierService) List(ctx context.Context, productID int, params *ProductModifierListParams) ([]ProductModifier, *Response, error) {
+	var modifiers []ProductModifier
+
+	response, err := performV3GET(ctx, s.client, s.servicePath(productID), params, &modifiers)
+
+	return modifiers, response, err
+}
+
+// ProductModifierIterator iterates over the ProductModifiers of all pages.
+type ProductModifierIterator struct {
+	iterator
+	modifiers []ProductModifier
+}
+
+// Next advances the iterator to the next ProductModifier. It returns false once all pages are consumed or an error occurred.
+func (it *ProductModifierIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current ProductModifier. It is only valid after Next returned true.
+func (it *ProductModifierIterator) Value() ProductModifier {
+	return it.modifiers[it.index]
+}
+
+// ListAll returns a ProductModifierIterator over the ProductModifiers of all pages for the given Product.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *ProductModifierService) ListAll(ctx context.Context, productID int, params *ProductModifierListParams) *ProductModifierIterator {
+	var p ProductModifierListParams
+	if params != nil {
+		p = *params
+	}
+	it := &ProductModifierIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		modifiers, response, err := s.List(ctx, productID, &p)
+		it.modifiers = modifiers
+		it.paginate(response)
+		return len(modifiers), err
+	})
+	return it
+}
+
+// Show returns the requested ProductModifier.
+func (s *ProductModifierService) Show(ctx context.Context, productID int, id int) (*ProductModifier, *Response, error) {
+	modifier := new(ProductModifier)
+
+	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
+	response, err := performV3GET(ctx, s.client, path, nil, modifier)
+
+	return modifier, response, err
+}
+
+// ProductModifierBody describes the modifier information given when creating or editing a ProductModifier.
+// DisplayName, Type and Required are required when creating a modifier. Unset fields are left untouched when editing.
+type ProductModifierBody struct {
+	DisplayName  string                 `json:"display_name,omitempty"`
+	Type         OptionType             `json:"type,omitempty"`
+	Required     *bool                  `json:"required,omitempty"`
+	SortOrder    *int                   `json:"sort_order,omitempty"`
+	Config       *ProductModifierConfig `json:"config,omitempty"`
+	OptionValues []ProductModifierValue `json:"option_values,omitempty"`
+}
+
+// New creates a new ProductModifier for the given Product and returns the new modifier.
+func (s *ProductModifierService) New(ctx context.Context, productID int, body *ProductModifierBody) (*ProductModifier, *Response, error) {
+	modifier := new(ProductModifier)
+
+	response, err := performV3POST(ctx, s.client, s.servicePath(productID), nil, body, modifier)
+
+	return modifier, response, err
+}
+
+// Edit updates the given ProductModifier with the given ProductModifierBody.
+func (s *ProductModifierService) Edit(ctx context.Context, productID int, id int, body *ProductModifierBody) (*ProductModifier, *Response, error) {
+	modifier := new(ProductModifier)
+
+	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
+	response, err := performV3PUT(ctx, s.client, path, nil, body, modifier)
+
+	return modifier, response, err
+}
+
+// Delete deletes the given ProductModifier.
+func (s *ProductModifierService) Delete(ctx context.Context, productID int, id int) (*Response, error) {
+	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
+	return performV3DELETE(ctx, s.client, path, nil)
+}
+
+func (s *ProductModifierService) servicePath(productID int) string {
+	return fmt.Sprintf("catalog/products/%d/modifiers", productID)
+}
@@ -0,0 +1,379 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductModifierService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": [{ "id": 123 }] }`)
+	})
+
+	expected := []ProductModifier{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductModifierListParams{
+		Page: 1,
+	}
+	modifiers, _, err := client.ProductModifiers.List(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, modifiers)
+}
+
+func TestProductModifierService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductModifierListParams{
+		Page: 1,
+	}
+	modifiers, _, err := client.ProductModifiers.List(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(modifiers) == 0)
+}
+
+func TestProductModifierService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{ "data": [{ "id": 1 }, { "id": 2 }], "meta": { "pagination": { "total": 3, "current_page": 1, "total_pages": 2 } } }`)
+		default:
+			fmt.Fprint(w, `{ "data": [{ "id": 3 }], "meta": { "pagination": { "total": 3, "current_page": 2, "total_pages": 2 } } }`)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	var modifiers []ProductModifier
+	it := client.ProductModifiers.ListAll(context.Background(), 12, nil)
+	for it.Next() {
+		modifiers = append(modifiers, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []ProductModifier{{ID: 1}, {ID: 2}, {ID: 3}}, modifiers)
+}
+
+func TestProductModifierService_Show(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 123 } }`)
+	})
+
+	expected := &ProductModifier{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	modifier, _, err := client.ProductModifiers.Show(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, modifier)
+}
+
+func TestProductModifierService_ShowWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	_, _, err := client.ProductModifiers.Show(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductModifierService_New(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 123 } }`)
+	})
+
+	expected := &ProductModifier{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	body := &ProductModifierBody{
+		DisplayName: "Gift wrap",
+		Type:        OptionTypeCheckbox,
+	}
+	modifier, _, err := client.ProductModifiers.New(context.Background(), 12, body)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, modifier)
+}
+
+func TestProductModifierService_NewWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	body := &ProductModifierBody{
+		DisplayName: "Gift wrap",
+		Type:        OptionTypeCheckbox,
+	}
+	_, _, err := client.ProductModifiers.New(context.Background(), 12, body)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductModifierService_Edit(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 123 } }`)
+	})
+
+	expected := &ProductModifier{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductModifierBody{
+		DisplayName: "Gift wrapping",
+	}
+	modifier, _, err := client.ProductModifiers.Edit(context.Background(), 12, 3, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, modifier)
+}
+
+func TestProductModifierService_EditWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductModifierBody{
+		DisplayName: "Gift wrapping",
+	}
+	_, _, err := client.ProductModifiers.Edit(context.Background(), 12, 3, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductModifierService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	response, err := client.ProductModifiers.Delete(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestProductModifierService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	_, err := client.ProductModifiers.Delete(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductModifierService_ShowAdjusters(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": {
+  "id": 3,
+  "product_id": 12,
+  "name": "Engraving",
+  "display_name": "Engraving",
+  "type": "dropdown",
+  "required": true,
+  "config": {},
+  "option_values": [{
+    "id": 9,
+    "option_id": 3,
+    "label": "Gold",
+    "sort_order": 0,
+    "is_default": false,
+    "adjusters": {
+      "price": { "adjuster": "relative", "adjuster_value": 5 },
+      "weight": { "adjuster": "percentage", "adjuster_value": 2.5 },
+      "image_url": "",
+      "purchasing_disabled": { "status": false, "message": "" }
+    }
+  }]
+} }`)
+	})
+
+	expected := &ProductModifier{
+		ID:          3,
+		ProductID:   12,
+		Name:        "Engraving",
+		DisplayName: "Engraving",
+		Type:        OptionTypeDropdown,
+		Required:    true,
+		OptionValues: []ProductModifierValue{
+			{
+				ID:       9,
+				OptionID: 3,
+				Label:    "Gold",
+				Adjusters: &ModifierAdjusters{
+					Price:              &ModifierAdjuster{Adjuster: AdjusterTypeRelative, AdjusterValue: 5},
+					Weight:             &ModifierAdjuster{Adjuster: AdjusterTypePercentage, AdjusterValue: 2.5},
+					PurchasingDisabled: &ModifierPurchasingDisabled{},
+				},
+			},
+		},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	modifier, _, err := client.ProductModifiers.Show(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, modifier)
+}
+
+func TestProductModifierService_NewBody(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{
+  "display_name": "Engraving",
+  "type": "text",
+  "required": false,
+  "config": { "text_characters_limited": true, "text_max_length": 20 }
+}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 4 } }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	required := false
+	body := &ProductModifierBody{
+		DisplayName: "Engraving",
+		Type:        OptionTypeText,
+		Required:    &required,
+		Config: &ProductModifierConfig{
+			TextCharactersLimited: true,
+			TextMaxLength:         20,
+		},
+	}
+	modifier, _, err := client.ProductModifiers.New(context.Background(), 12, body)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, modifier.ID)
+}
+
+func TestProductModifierService_EditBody(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/modifiers/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{ "display_name": "Gift wrapping" }`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 3, "display_name": "Gift wrapping", "required": true } }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductModifierBody{
+		DisplayName: "Gift wrapping",
+	}
+	modifier, _, err := client.ProductModifiers.Edit(context.Background(), 12, 3, params)
+	assert.Nil(t, err)
+	assert.True(t, modifier.Required)
+}
@@ -0,0 +1,163 @@
+package bigcommerce
+
+import (
+	"fmt"
+
+	"context"
+)
+
+// OptionType defines how an option or modifier is displayed on the storefront.
+type OptionType string
+
+// Option types supported by Bigcommerce. Options, which create variants, only support the
+// types up to OptionTypeSwatch. Modifiers additionally support the types without option values.
+const (
+	OptionTypeRadioButtons          OptionType = "radio_buttons"
+	OptionTypeRectangles            OptionType = "rectangles"
+	OptionTypeDropdown              OptionType = "dropdown"
+	OptionTypeProductList           OptionType = "product_list"
+	OptionTypeProductListWithImages OptionType = "product_list_with_images"
+	OptionTypeSwatch                OptionType = "swatch"
+	OptionTypeCheckbox              OptionType = "checkbox"
+	OptionTypeDate                  OptionType = "date"
+	OptionTypeFile                  OptionType = "file"
+	OptionTypeText                  OptionType = "text"
+	OptionTypeMultiLineText         OptionType = "multi_line_text"
+	OptionTypeNumbersOnlyText       OptionType = "numbers_only_text"
+)
+
+// ProductOption describes the product option resource. The values of options are combined into variants.
+type ProductOption struct {
+	ID           int                  `json:"id"`
+	ProductID    int                  `json:"product_id"`
+	DisplayName  string               `json:"display_name"`
+	Type         OptionType           `json:"type"`
+	SortOrder    int                  `json:"sort_order"`
+	OptionValues []ProductOptionValue `json:"option_values"`
+}
+
+// ProductOptionValue describes a value of a ProductOption.
+type ProductOptionValue struct {
+	ID        int              `json:"id,omitempty"`
+	Label     string           `json:"label"`
+	SortOrder int              `json:"sort_order"`
+	IsDefault bool             `json:"is_default"`
+	ValueData *OptionValueData `json:"value_data,omitempty"`
+}
+
+// OptionValueData describes the type specific data of an option value, i.e.
+// the colors or image of a swatch or the product of a product list.
+type OptionValueData struct {
+	Colors    []string `json:"colors,omitempty"`
+	ImageURL  string   `json:"image_url,omitempty"`
+	ProductID int      `json:"product_id,omitempty"`
+}
+
+// ProductOptionService adds the APIs for the ProductOption resource.
+// It uses the V3 catalog API, which requires a client configured with OAuth credentials.
+type ProductOptionService struct {
+	client *Client
+}
+
+func newProductOptionService(client *Client) *ProductOptionService {
+	return &ProductOptionService{
+		client: client,
+	}
+}
+
+// ProductOptionListParams are the parameters for ProductOptionService.List
+type ProductOptionListParams struct {
+	Page  int `url:"page,omitempty"`
+	Limit int `url:"limit,omitempty"`
+}
+
+// List returns a list of ProductOptions of the given Product matching the given ProductOptionListParams.
+func (s *ProductOptionService) List(ctx context.Context, productID int, params *ProductOptionListParams) ([]ProductOption, *Response, error) {
+	var options []ProductOption
+
+	response, err := performV3GET(ctx, s.client, s.servicePath(productID), params, &options)
+
+	return options, response, err
+}
+
+// ProductOptionIterator iterates over the ProductOptions of all pages.
+type ProductOptionIterator struct {
+	iterator
+	options []ProductOption
+}
+
+// Next advances the iterator to the next ProductOption. It returns false once all pages are consumed or an error occurred.
+func (it *ProductOptionIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current ProductOption. It is only valid after Next returned true.
+func (it *ProductOptionIterator) Value() ProductOption {
+	return it.options[it.index]
+}
+
+// ListAll returns a ProductOptionIterator over the ProductOptions of all pages for the given Product.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *ProductOptionService) ListAll(ctx context.Context, productID int, params *ProductOptionListParams) *ProductOptionIterator {
+	var p ProductOptionListParams
+	if params != nil {
+		p = *params
+	}
+	it := &ProductOptionIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		options, response, err := s.List(ctx, productID, &p)
+		it.options = options
+		it.paginate(response)
+		return len(options), err
+	})
+	return it
+}
+
+// Show returns the requested ProductOption.
+func (s *ProductOptionService) Show(ctx context.Context, productID int, id int) (*ProductOption, *Response, error) {
+	option := new(ProductOption)
+
+	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
+	response, err := performV3GET(ctx, s.client, path, nil, option)
+
+	return option, response, err
+}
+
+// ProductOptionBody describes the option information given when creating or editing a ProductOption.
+// DisplayName, Type and OptionValues are required when creating an option.
+type ProductOptionBody struct {
+	DisplayName  string               `json:"display_name,omitempty"`
+	Type         OptionType           `json:"type,omitempty"`
+	SortOrder    *int                 `json:"sort_order,omitempty"`
+	OptionValues []ProductOptionValue `json:"option_values,omitempty"`
+}
+
+// New creates a new ProductOption for the given Product and returns the new option.
+func (s *ProductOptionService) New(ctx context.Context, productID int, body *ProductOptionBody) (*ProductOption, *Response, error) {
+	option := new(ProductOption)
+
+	response, err := performV3POST(ctx, s.client, s.servicePath(productID), nil, body, option)
+
+	return option, response, err
+}
+
+// Edit updates the given ProductOption with the given ProductOptionBody.
+func (s *ProductOptionService) Edit(ctx context.Context, productID int, id int, body *ProductOptionBody) (*ProductOption, *Response, error) {
+	option := new(ProductOption)
+
+	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
+	response, err := performV3PUT(ctx, s.client, path, nil, body, option)
+
+	return option, response, err
+}
+
+// Delete deletes the given ProductOption.
+func (s *ProductOptionService) Delete(ctx context.Context, productID int, id int) (*Response, error) {
+	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
+	return performV3DELETE(ctx, s.client, path, nil)
+}
+
+func (s *ProductOptionService) servicePath(productID int) string {
+	return fmt.Sprintf("catalog/products/%d/options", productID)
+}
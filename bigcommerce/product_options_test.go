@@ -0,0 +1,340 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductOptionService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/options", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": [{ "id": 123 }] }`)
+	})
+
+	expected := []ProductOption{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductOptionListParams{
+		Page: 1,
+	}
+	options, _, err := client.ProductOptions.List(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, options)
+}
+
+func TestProductOptionService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/options", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductOptionListParams{
+		Page: 1,
+	}
+	options, _, err := client.ProductOptions.List(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(options) == 0)
+}
+
+func TestProductOptionService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/options", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{ "data": [{ "id": 1 }, { "id": 2 }], "meta": { "pagination": { "total": 3, "current_page": 1, "total_pages": 2 } } }`)
+		default:
+			fmt.Fprint(w, `{ "data": [{ "id": 3 }], "meta": { "pagination": { "total": 3, "current_page": 2, "total_pages": 2 } } }`)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	var options []ProductOption
+	it := client.ProductOptions.ListAll(context.Background(), 12, nil)
+	for it.Next() {
+		options = append(options, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []ProductOption{{ID: 1}, {ID: 2}, {ID: 3}}, options)
+}
+
+func TestProductOptionService_Show(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/options/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 123 } }`)
+	})
+
+	expected := &ProductOption{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	option, _, err := client.ProductOptions.Show(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, option)
+}
+
+func TestProductOptionService_ShowWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/options/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	_, _, err := client.ProductOptions.Show(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductOptionService_New(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/options", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 123 } }`)
+	})
+
+	expected := &ProductOption{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	body := &ProductOptionBody{
+		DisplayName: "Color",
+		Type:        OptionTypeSwatch,
+		OptionValues: []ProductOptionValue{
+			{Label: "Red", ValueData: &OptionValueData{Colors: []string{"#FF0000"}}},
+		},
+	}
+	option, _, err := client.ProductOptions.New(context.Background(), 12, body)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, option)
+}
+
+func TestProductOptionService_NewWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/options", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	body := &ProductOptionBody{
+		DisplayName: "Color",
+		Type:        OptionTypeSwatch,
+		OptionValues: []ProductOptionValue{
+			{Label: "Red", ValueData: &OptionValueData{Colors: []string{"#FF0000"}}},
+		},
+	}
+	_, _, err := client.ProductOptions.New(context.Background(), 12, body)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductOptionService_Edit(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/options/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 123 } }`)
+	})
+
+	expected := &ProductOption{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductOptionBody{
+		DisplayName: "Colour",
+	}
+	option, _, err := client.ProductOptions.Edit(context.Background(), 12, 3, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, option)
+}
+
+func TestProductOptionService_EditWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/options/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductOptionBody{
+		DisplayName: "Colour",
+	}
+	_, _, err := client.ProductOptions.Edit(context.Background(), 12, 3, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductOptionService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/options/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	response, err := client.ProductOptions.Delete(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestProductOptionService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/options/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	_, err := client.ProductOptions.Delete(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductOptionService_ShowOptionValues(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/options/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": {
+  "id": 3,
+  "product_id": 12,
+  "display_name": "Color",
+  "type": "swatch",
+  "sort_order": 1,
+  "option_values": [
+    { "id": 7, "label": "Red", "sort_order": 0, "is_default": true, "value_data": { "colors": ["#FF0000"] } },
+    { "id": 8, "label": "Plaid", "sort_order": 1, "is_default": false, "value_data": { "image_url": "https://example.com/plaid.png" } }
+  ]
+} }`)
+	})
+
+	expected := &ProductOption{
+		ID:          3,
+		ProductID:   12,
+		DisplayName: "Color",
+		Type:        OptionTypeSwatch,
+		SortOrder:   1,
+		OptionValues: []ProductOptionValue{
+			{ID: 7, Label: "Red", IsDefault: true, ValueData: &OptionValueData{Colors: []string{"#FF0000"}}},
+			{ID: 8, Label: "Plaid", SortOrder: 1, ValueData: &OptionValueData{ImageURL: "https://example.com/plaid.png"}},
+		},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	option, _, err := client.ProductOptions.Show(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, option)
+}
+
+func TestProductOptionService_NewBody(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/options", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{
+  "display_name": "Size",
+  "type": "rectangles",
+  "option_values": [
+    { "label": "S", "sort_order": 0, "is_default": true },
+    { "label": "M", "sort_order": 1, "is_default": false }
+  ]
+}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 4 } }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	body := &ProductOptionBody{
+		DisplayName: "Size",
+		Type:        OptionTypeRectangles,
+		OptionValues: []ProductOptionValue{
+			{Label: "S", IsDefault: true},
+			{Label: "M", SortOrder: 1},
+		},
+	}
+	option, _, err := client.ProductOptions.New(context.Background(), 12, body)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, option.ID)
+}
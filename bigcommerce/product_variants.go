@@ -0,0 +1,257 @@
+package bigcommerce
+
+import (
+	"fmt"
+
+	"context"
+)
+
+// ProductVariant describes the product variant resource. Variants are the
+// purchasable SKUs of a Product, identified by a combination of OptionValues.
+type ProductVariant struct {
+	ID                    int                         `json:"id"`
+	ProductID             int                         `json:"product_id"`
+	Sku                   string                      `json:"sku"`
+	Price                 *float64                    `json:"price"`
+	CalculatedPrice       float64                     `json:"calculated_price"`
+	CostPrice             *float64                    `json:"cost_price"`
+	Weight                *float64                    `json:"weight"`
+	InventoryLevel        int                         `json:"inventory_level"`
+	InventoryWarningLevel int                         `json:"inventory_warning_level"`
+	BinPickingNumber      string                      `json:"bin_picking_number"`
+	UPC                   string                      `json:"upc"`
+	PurchasingDisabled    bool                        `json:"purchasing_disabled"`
+	OptionValues          []ProductVariantOptionValue `json:"option_values"`
+}
+
+// ProductVariantOptionValue describes the option value of a ProductVariant.
+// ID is the id of the option value and OptionID the id of the product option it belongs to.
+type ProductVariantOptionValue struct {
+	ID                int    `json:"id"`
+	OptionID          int    `json:"option_id"`
+	Label             string `json:"label,omitempty"`
+	OptionDisplayName string `json:"option_display_name,omitempty"`
+}
+
+// ProductVariantService adds the APIs for the ProductVariant resource.
+// The V3 catalog API is used when the client is configured with OAuth credentials.
+// Otherwise the V2 SKU API is used, which does not support weight, calculated_price
+// and purchasing_disabled, and only returns the ids of the option values.
+type ProductVariantService struct {
+	client *Client
+}
+
+func newProductVariantService(client *Client) *ProductVariantService {
+	return &ProductVariantService{
+		client: client,
+	}
+}
+
+// ProductVariantListParams are the parameters for ProductVariantService.List
+type ProductVariantListParams struct {
+	Page  int `url:"page,omitempty"`
+	Limit int `url:"limit,omitempty"`
+}
+
+// List returns a list of ProductVariants of the given Product matching the given ProductVariantListParams.
+func (s *ProductVariantService) List(ctx context.Context, productID int, params *ProductVariantListParams) ([]ProductVariant, *Response, error) {
+	if !s.client.config.usesOAuth() {
+		var skus []productSku
+		response, err := performGET(ctx, s.client, s.skuPath(productID), params, &skus)
+		variants := make([]ProductVariant, 0, len(skus))
+		for _, sku := range skus {
+			variants = append(variants, sku.variant())
+		}
+		return variants, response, err
+	}
+	var variants []ProductVariant
+
+	response, err := performV3GET(ctx, s.client, s.servicePath(productID), params, &variants)
+
+	return variants, response, err
+}
+
+// ProductVariantIterator iterates over the ProductVariants of all pages.
+type ProductVariantIterator struct {
+	iterator
+	variants []ProductVariant
+}
+
+// Next advances the iterator to the next ProductVariant. It returns false once all pages are consumed or an error occurred.
+func (it *ProductVariantIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current ProductVariant. It is only valid after Next returned true.
+func (it *ProductVariantIterator) Value() ProductVariant {
+	return it.variants[it.index]
+}
+
+// ListAll returns a ProductVariantIterator over the ProductVariants of all pages for the given Product.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *ProductVariantService) ListAll(ctx context.Context, productID int, params *ProductVariantListParams) *ProductVariantIterator {
+	var p ProductVariantListParams
+	if params != nil {
+		p = *params
+	}
+	it := &ProductVariantIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		variants, response, err := s.List(ctx, productID, &p)
+		it.variants = variants
+		it.paginate(response)
+		return len(variants), err
+	})
+	return it
+}
+
+// Show returns the requested ProductVariant.
+func (s *ProductVariantService) Show(ctx context.Context, productID int, id int) (*ProductVariant, *Response, error) {
+	if !s.client.config.usesOAuth() {
+		sku := new(productSku)
+		path := fmt.Sprintf("%v/%d", s.skuPath(productID), id)
+		response, err := performGET(ctx, s.client, path, nil, sku)
+		variant := sku.variant()
+		return &variant, response, err
+	}
+	variant := new(ProductVariant)
+
+	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
+	response, err := performV3GET(ctx, s.client, path, nil, variant)
+
+	return variant, response, err
+}
+
+// ProductVariantBody describes the variant information given when creating or editing a ProductVariant.
+// OptionValues are required when creating a variant. Unset fields are left untouched when editing.
+type ProductVariantBody struct {
+	Sku                   string                      `json:"sku,omitempty"`
+	Price                 *float64                    `json:"price,omitempty"`
+	CostPrice             *float64                    `json:"cost_price,omitempty"`
+	Weight                *float64                    `json:"weight,omitempty"`
+	InventoryLevel        *int                        `json:"inventory_level,omitempty"`
+	InventoryWarningLevel *int                        `json:"inventory_warning_level,omitempty"`
+	BinPickingNumber      string                      `json:"bin_picking_number,omitempty"`
+	UPC                   string                      `json:"upc,omitempty"`
+	PurchasingDisabled    *bool                       `json:"purchasing_disabled,omitempty"`
+	OptionValues          []ProductVariantOptionValue `json:"option_values,omitempty"`
+}
+
+// New creates a new ProductVariant for the given Product and returns the new variant.
+func (s *ProductVariantService) New(ctx context.Context, productID int, body *ProductVariantBody) (*ProductVariant, *Response, error) {
+	if !s.client.config.usesOAuth() {
+		sku := new(productSku)
+		response, err := performPOST(ctx, s.client, s.skuPath(productID), nil, newProductSku(body), sku)
+		variant := sku.variant()
+		return &variant, response, err
+	}
+	variant := new(ProductVariant)
+
+	response, err := performV3POST(ctx, s.client, s.servicePath(productID), nil, body, variant)
+
+	return variant, response, err
+}
+
+// Edit updates the given ProductVariant with the given ProductVariantBody.
+func (s *ProductVariantService) Edit(ctx context.Context, productID int, id int, body *ProductVariantBody) (*ProductVariant, *Response, error) {
+	if !s.client.config.usesOAuth() {
+		sku := new(productSku)
+		path := fmt.Sprintf("%v/%d", s.skuPath(productID), id)
+		response, err := performPUT(ctx, s.client, path, nil, newProductSku(body), sku)
+		variant := sku.variant()
+		return &variant, response, err
+	}
+	variant := new(ProductVariant)
+
+	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
+	response, err := performV3PUT(ctx, s.client, path, nil, body, variant)
+
+	return variant, response, err
+}
+
+// Delete deletes the given ProductVariant.
+func (s *ProductVariantService) Delete(ctx context.Context, productID int, id int) (*Response, error) {
+	if !s.client.config.usesOAuth() {
+		path := fmt.Sprintf("%v/%d", s.skuPath(productID), id)
+		return performDELETE(ctx, s.client, path, nil)
+	}
+	path := fmt.Sprintf("%v/%d", s.servicePath(productID), id)
+	return performV3DELETE(ctx, s.client, path, nil)
+}
+
+func (s *ProductVariantService) servicePath(productID int) string {
+	return fmt.Sprintf("catalog/products/%d/variants", productID)
+}
+
+func (s *ProductVariantService) skuPath(productID int) string {
+	return fmt.Sprintf("products/%d/skus", productID)
+}
+
+// productSku describes the V2 SKU resource, which is mapped to and from ProductVariant.
+type productSku struct {
+	ID                    int                `json:"id,omitempty"`
+	ProductID             int                `json:"product_id,omitempty"`
+	Sku                   string             `json:"sku,omitempty"`
+	Price                 *float64           `json:"price,string,omitempty"`
+	AdjustedPrice         float64            `json:"adjusted_price,string,omitempty"`
+	CostPrice             *float64           `json:"cost_price,string,omitempty"`
+	InventoryLevel        *int               `json:"inventory_level,omitempty"`
+	InventoryWarningLevel *int               `json:"inventory_warning_level,omitempty"`
+	BinPickingNumber      string             `json:"bin_picking_number,omitempty"`
+	UPC                   string             `json:"upc,omitempty"`
+	Options               []productSkuOption `json:"options,omitempty"`
+}
+
+// productSkuOption describes the option value of a V2 SKU.
+type productSkuOption struct {
+	ProductOptionID int `json:"product_option_id"`
+	OptionValueID   int `json:"option_value_id"`
+}
+
+func newProductSku(body *ProductVariantBody) *productSku {
+	if body == nil {
+		return nil
+	}
+	sku := &productSku{
+		Sku:                   body.Sku,
+		Price:                 body.Price,
+		CostPrice:             body.CostPrice,
+		InventoryLevel:        body.InventoryLevel,
+		InventoryWarningLevel: body.InventoryWarningLevel,
+		BinPickingNumber:      body.BinPickingNumber,
+		UPC:                   body.UPC,
+	}
+	for _, value := range body.OptionValues {
+		sku.Options = append(sku.Options, productSkuOption{
+			ProductOptionID: value.OptionID,
+			OptionValueID:   value.ID,
+		})
+	}
+	return sku
+}
+
+func (sku productSku) variant() ProductVariant {
+	variant := ProductVariant{
+		ID:               sku.ID,
+		ProductID:        sku.ProductID,
+		Sku:              sku.Sku,
+		Price:            sku.Price,
+		CalculatedPrice:  sku.AdjustedPrice,
+		CostPrice:        sku.CostPrice,
+		BinPickingNumber: sku.BinPickingNumber,
+		UPC:              sku.UPC,
+	}
+	if sku.InventoryLevel != nil {
+		variant.InventoryLevel = *sku.InventoryLevel
+	}
+	if sku.InventoryWarningLevel != nil {
+		variant.InventoryWarningLevel = *sku.InventoryWarningLevel
+	}
+	for _, option := range sku.Options {
+		variant.OptionValues = append(variant.OptionValues, ProductVariantOptionValue{
+			ID:       option.OptionValueID,
+			OptionID: option.ProductOptionID,
+		})
+	}
+	return variant
+}
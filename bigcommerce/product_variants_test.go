@@ -0,0 +1,430 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductVariantService_List(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/variants", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": [{ "id": 123 }] }`)
+	})
+
+	expected := []ProductVariant{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductVariantListParams{
+		Page: 1,
+	}
+	variants, _, err := client.ProductVariants.List(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, variants)
+}
+
+func TestProductVariantService_ListWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/variants", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductVariantListParams{
+		Page: 1,
+	}
+	variants, _, err := client.ProductVariants.List(context.Background(), 12, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(variants) == 0)
+}
+
+func TestProductVariantService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/variants", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{ "data": [{ "id": 1 }, { "id": 2 }], "meta": { "pagination": { "total": 3, "current_page": 1, "total_pages": 2 } } }`)
+		default:
+			fmt.Fprint(w, `{ "data": [{ "id": 3 }], "meta": { "pagination": { "total": 3, "current_page": 2, "total_pages": 2 } } }`)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	var variants []ProductVariant
+	it := client.ProductVariants.ListAll(context.Background(), 12, nil)
+	for it.Next() {
+		variants = append(variants, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []ProductVariant{{ID: 1}, {ID: 2}, {ID: 3}}, variants)
+}
+
+func TestProductVariantService_Show(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/variants/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 123 } }`)
+	})
+
+	expected := &ProductVariant{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	variant, _, err := client.ProductVariants.Show(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, variant)
+}
+
+func TestProductVariantService_ShowWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/variants/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	_, _, err := client.ProductVariants.Show(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductVariantService_New(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/variants", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 123 } }`)
+	})
+
+	expected := &ProductVariant{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	body := &ProductVariantBody{
+		Sku: "SHIRT-RED-M",
+		OptionValues: []ProductVariantOptionValue{
+			{ID: 7, OptionID: 2},
+		},
+	}
+	variant, _, err := client.ProductVariants.New(context.Background(), 12, body)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, variant)
+}
+
+func TestProductVariantService_NewWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/variants", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	body := &ProductVariantBody{
+		Sku: "SHIRT-RED-M",
+		OptionValues: []ProductVariantOptionValue{
+			{ID: 7, OptionID: 2},
+		},
+	}
+	_, _, err := client.ProductVariants.New(context.Background(), 12, body)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductVariantService_Edit(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/variants/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "data": { "id": 123 } }`)
+	})
+
+	expected := &ProductVariant{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductVariantBody{
+		Sku: "SHIRT-RED-L",
+	}
+	variant, _, err := client.ProductVariants.Edit(context.Background(), 12, 3, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, variant)
+}
+
+func TestProductVariantService_EditWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/variants/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	params := &ProductVariantBody{
+		Sku: "SHIRT-RED-L",
+	}
+	_, _, err := client.ProductVariants.Edit(context.Background(), 12, 3, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductVariantService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/variants/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	response, err := client.ProductVariants.Delete(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestProductVariantService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/12/variants/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestV3JSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		StoreHash:   "abc123",
+		ClientID:    "client-id",
+		AccessToken: "access-token"})
+	_, err := client.ProductVariants.Delete(context.Background(), 12, 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductVariantService_ListV2(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/skus", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"page": "1"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{
+  "id": 3,
+  "product_id": 12,
+  "sku": "SHIRT-RED-M",
+  "price": "12.5000",
+  "adjusted_price": "14.0000",
+  "cost_price": null,
+  "inventory_level": 8,
+  "inventory_warning_level": 2,
+  "options": [{ "product_option_id": 2, "option_value_id": 7 }]
+}]`)
+	})
+
+	price := 12.5
+	expected := []ProductVariant{
+		{
+			ID:                    3,
+			ProductID:             12,
+			Sku:                   "SHIRT-RED-M",
+			Price:                 &price,
+			CalculatedPrice:       14,
+			InventoryLevel:        8,
+			InventoryWarningLevel: 2,
+			OptionValues: []ProductVariantOptionValue{
+				{ID: 7, OptionID: 2},
+			},
+		},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &ProductVariantListParams{
+		Page: 1,
+	}
+	variants, _, err := client.ProductVariants.List(context.Background(), 12, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, variants)
+}
+
+func TestProductVariantService_ListV2WithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/skus", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	variants, _, err := client.ProductVariants.List(context.Background(), 12, nil)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.True(t, len(variants) == 0)
+}
+
+func TestProductVariantService_ShowV2(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/skus/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 3, "product_id": 12, "price": null }`)
+	})
+
+	expected := &ProductVariant{ID: 3, ProductID: 12}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	variant, _, err := client.ProductVariants.Show(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, variant)
+}
+
+func TestProductVariantService_NewV2(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/skus", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{
+  "sku": "SHIRT-RED-M",
+  "price": "12.5",
+  "inventory_level": 0,
+  "options": [{ "product_option_id": 2, "option_value_id": 7 }]
+}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 3 }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	price := 12.5
+	inventoryLevel := 0
+	body := &ProductVariantBody{
+		Sku:            "SHIRT-RED-M",
+		Price:          &price,
+		InventoryLevel: &inventoryLevel,
+		OptionValues: []ProductVariantOptionValue{
+			{ID: 7, OptionID: 2},
+		},
+	}
+	variant, _, err := client.ProductVariants.New(context.Background(), 12, body)
+	assert.Nil(t, err)
+	assert.Equal(t, &ProductVariant{ID: 3}, variant)
+}
+
+func TestProductVariantService_EditV2(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/skus/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{ "inventory_level": 5 }`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 3, "inventory_level": 5 }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	inventoryLevel := 5
+	params := &ProductVariantBody{
+		InventoryLevel: &inventoryLevel,
+	}
+	variant, _, err := client.ProductVariants.Edit(context.Background(), 12, 3, params)
+	assert.Nil(t, err)
+	assert.Equal(t, &ProductVariant{ID: 3, InventoryLevel: 5}, variant)
+}
+
+func TestProductVariantService_DeleteV2(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/12/skus/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	response, err := client.ProductVariants.Delete(context.Background(), 12, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
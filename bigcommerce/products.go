@@ -2,26 +2,72 @@ package bigcommerce
 
 import (
 	"fmt"
-	"net/http"
+	"strings"
+	"time"
 
 	"context"
 )
 
 const productServicePath = "products/"
 
+// ProductType defines the type of a Product.
+type ProductType string
+
+// Product types supported by Bigcommerce.
+const (
+	ProductTypePhysical ProductType = "physical"
+	ProductTypeDigital  ProductType = "digital"
+)
+
+// ProductAvailability defines whether a Product can be purchased.
+type ProductAvailability string
+
+// Product availabilities supported by Bigcommerce.
+const (
+	ProductAvailabilityAvailable ProductAvailability = "available"
+	ProductAvailabilityDisabled  ProductAvailability = "disabled"
+	ProductAvailabilityPreorder  ProductAvailability = "preorder"
+)
+
+// InventoryTracking defines how the inventory of a Product is tracked.
+type InventoryTracking string
+
+// Inventory tracking methods supported by Bigcommerce.
+const (
+	InventoryTrackingNone   InventoryTracking = "none"
+	InventoryTrackingSimple InventoryTracking = "simple"
+	InventoryTrackingSku    InventoryTracking = "sku"
+)
+
 // Product describes the product resource
 type Product struct {
-	ID             int                `json:"id"`
-	Name           string             `json:"name"`
-	Sku            string             `json:"sku"`
-	Description    string             `json:"description"`
-	Price          string             `json:"price"`
-	CostPrice      string             `json:"cost_price"`
-	RetailPrice    string             `json:"retail_price"`
-	InventoryLevel int                `json:"inventory_level"`
-	TotalSold      int                `json:"total_sold"`
-	Availability   string             `json:"availability"`
-	PrimaryImage   PrimaryImageEntity `json:"primary_image"`
+	ID                    int                 `json:"id"`
+	Name                  string              `json:"name"`
+	Type                  ProductType         `json:"type"`
+	Sku                   string              `json:"sku"`
+	Description           string              `json:"description"`
+	Price                 string              `json:"price"`
+	CostPrice             string              `json:"cost_price"`
+	RetailPrice           string              `json:"retail_price"`
+	SalePrice             string              `json:"sale_price"`
+	CalculatedPrice       string              `json:"calculated_price"`
+	Weight                string              `json:"weight"`
+	Width                 string              `json:"width"`
+	Height                string              `json:"height"`
+	Depth                 string              `json:"depth"`
+	Categories            []int               `json:"categories"`
+	BrandID               int                 `json:"brand_id"`
+	InventoryLevel        int                 `json:"inventory_level"`
+	InventoryWarningLevel int                 `json:"inventory_warning_level"`
+	InventoryTracking     InventoryTracking   `json:"inventory_tracking"`
+	TotalSold             int                 `json:"total_sold"`
+	Availability          ProductAvailability `json:"availability"`
+	IsVisible             bool                `json:"is_visible"`
+	IsFeatured            bool                `json:"is_featured"`
+	SortOrder             int                 `json:"sort_order"`
+	DateCreated           BCTime              `json:"date_created"`
+	DateModified          BCTime              `json:"date_modified"`
+	PrimaryImage          PrimaryImageEntity  `json:"primary_image"`
 }
 
 // PrimaryImageEntity describes the image entity.
@@ -34,47 +80,167 @@ type PrimaryImageEntity struct {
 
 // ProductService adds the APIs for the Product resource.
 type ProductService struct {
-	config     *ClientConfig
-	httpClient *http.Client
+	client *Client
 }
 
-func newProductService(config *ClientConfig, httpClient *http.Client) *ProductService {
+func newProductService(client *Client) *ProductService {
 	return &ProductService{
-		config:     config,
-		httpClient: httpClient,
+		client: client,
 	}
 }
 
 // ProductListParams are the parameters for ProductService.List
 type ProductListParams struct {
-	MinID             int    `url:"min_id,omitempty"`
-	MaxID             int    `url:"max_id,omitempty"`
-	Name              string `url:"name,omitempty"`
-	Sku               string `url:"sku,omitempty"`
-	Availability      string `url:"availability,omitempty"`
-	IsVisible         string `url:"is_visible,omitempty"`
-	IsFeatured        string `url:"is_featured,omitempty"`
-	MinInventoryLevel int    `url:"min_inventory_level,omitempty"`
-	MaxInventoryLevel int    `url:"max_inventory_level,omitempty"`
+	Page              int       `url:"page,omitempty"`
+	Limit             int       `url:"limit,omitempty"`
+	MinID             int       `url:"min_id,omitempty"`
+	MaxID             int       `url:"max_id,omitempty"`
+	Name              string    `url:"name,omitempty"`
+	KeywordFilter     string    `url:"keyword_filter,omitempty"`
+	Sku               string    `url:"sku,omitempty"`
+	Category          int       `url:"category,omitempty"`
+	BrandID           int       `url:"brand_id,omitempty"`
+	MinPrice          float64   `url:"min_price,omitempty"`
+	MaxPrice          float64   `url:"max_price,omitempty"`
+	Availability      string    `url:"availability,omitempty"`
+	IsVisible         string    `url:"is_visible,omitempty"`
+	IsFeatured        string    `url:"is_featured,omitempty"`
+	MinInventoryLevel int       `url:"min_inventory_level,omitempty"`
+	MaxInventoryLevel int       `url:"max_inventory_level,omitempty"`
+	MinDateCreated    time.Time `url:"min_date_created,omitempty"`
+	MaxDateCreated    time.Time `url:"max_date_created,omitempty"`
+	MinDateModified   time.Time `url:"min_date_modified,omitempty"`
+	MaxDateModified   time.Time `url:"max_date_modified,omitempty"`
 }
 
 // List returns a list of Products matching the given ProductListParams.
-func (s *ProductService) List(ctx context.Context, params *ProductListParams) ([]Product, *http.Response, error) {
+func (s *ProductService) List(ctx context.Context, params *ProductListParams) ([]Product, *Response, error) {
 	var products []Product
-	var apiError APIError
 
-	response, err := performGET(ctx, s.httpClient, s.config, productServicePath, params, &products, &apiError)
+	response, err := performGET(ctx, s.client, productServicePath, params, &products)
+
+	return products, response, err
+}
+
+// ProductIterator iterates over the Products of all pages matching the given ProductListParams.
+type ProductIterator struct {
+	iterator
+	products []Product
+}
+
+// Next advances the iterator to the next Product. It returns false once all pages are consumed or an error occurred.
+func (it *ProductIterator) Next() bool {
+	return it.next()
+}
+
+// Value returns the current Product. It is only valid after Next returned true.
+func (it *ProductIterator) Value() Product {
+	return it.products[it.index]
+}
+
+// ListAll returns a ProductIterator over the Products of all pages matching the given ProductListParams.
+// Iteration starts at params.Page and fetches the following pages lazily.
+func (s *ProductService) ListAll(ctx context.Context, params *ProductListParams) *ProductIterator {
+	var p ProductListParams
+	if params != nil {
+		p = *params
+	}
+	it := &ProductIterator{}
+	it.iterator = newIterator(ctx, p.Page, p.Limit, func(ctx context.Context, page int) (int, error) {
+		p.Page = page
+		products, _, err := s.List(ctx, &p)
+		it.products = products
+		return len(products), err
+	})
+	return it
+}
+
+// Count returns the number of Products matching the given ProductListParams.
+func (s *ProductService) Count(ctx context.Context, params *ProductListParams) (int, *Response, error) {
+	var cnt count
+
+	path := strings.Join([]string{productServicePath, "count"}, "")
+	response, err := performGET(ctx, s.client, path, params, &cnt)
 
-	return products, response, relevantError(err, apiError)
+	return cnt.Count, response, err
 }
 
 // Show returns the requested Product.
-func (s *ProductService) Show(ctx context.Context, id int32) (*Product, *http.Response, error) {
+func (s *ProductService) Show(ctx context.Context, id int32) (*Product, *Response, error) {
+	product := new(Product)
+
+	path := fmt.Sprintf("%v%v", productServicePath, id)
+	response, err := performGET(ctx, s.client, path, nil, &product)
+
+	return product, response, err
+}
+
+// ProductBody describes the product information given when creating a new Product.
+// Name, Type, Price, Weight, Categories and Availability are required.
+type ProductBody struct {
+	Name                  string              `json:"name"`
+	Type                  ProductType         `json:"type"`
+	Sku                   string              `json:"sku,omitempty"`
+	Description           string              `json:"description,omitempty"`
+	Price                 float64             `json:"price"`
+	CostPrice             float64             `json:"cost_price,omitempty"`
+	RetailPrice           float64             `json:"retail_price,omitempty"`
+	SalePrice             float64             `json:"sale_price,omitempty"`
+	Weight                float64             `json:"weight"`
+	Categories            []int               `json:"categories"`
+	BrandID               int                 `json:"brand_id,omitempty"`
+	Availability          ProductAvailability `json:"availability"`
+	InventoryTracking     InventoryTracking   `json:"inventory_tracking,omitempty"`
+	InventoryLevel        int                 `json:"inventory_level,omitempty"`
+	InventoryWarningLevel int                 `json:"inventory_warning_level,omitempty"`
+	IsVisible             bool                `json:"is_visible"`
+	IsFeatured            bool                `json:"is_featured,omitempty"`
+	SortOrder             int                 `json:"sort_order,omitempty"`
+}
+
+// New creates a new Product with the specified information and returns the new product.
+func (s *ProductService) New(ctx context.Context, body *ProductBody) (*Product, *Response, error) {
+	product := new(Product)
+
+	response, err := performPOST(ctx, s.client, productServicePath, nil, body, product)
+
+	return product, response, err
+}
+
+// ProductEditParams describes the fields that are editable on a Product.
+type ProductEditParams struct {
+	Name                  string              `json:"name,omitempty"`
+	Type                  ProductType         `json:"type,omitempty"`
+	Sku                   string              `json:"sku,omitempty"`
+	Description           string              `json:"description,omitempty"`
+	Price                 *float64            `json:"price,omitempty"`
+	CostPrice             *float64            `json:"cost_price,omitempty"`
+	RetailPrice           *float64            `json:"retail_price,omitempty"`
+	SalePrice             *float64            `json:"sale_price,omitempty"`
+	Weight                *float64            `json:"weight,omitempty"`
+	Categories            []int               `json:"categories,omitempty"`
+	BrandID               *int                `json:"brand_id,omitempty"`
+	Availability          ProductAvailability `json:"availability,omitempty"`
+	InventoryTracking     InventoryTracking   `json:"inventory_tracking,omitempty"`
+	InventoryLevel        *int                `json:"inventory_level,omitempty"`
+	InventoryWarningLevel *int                `json:"inventory_warning_level,omitempty"`
+	IsVisible             *bool               `json:"is_visible,omitempty"`
+	IsFeatured            *bool               `json:"is_featured,omitempty"`
+	SortOrder             *int                `json:"sort_order,omitempty"`
+}
+
+// Edit updates the given ProductEditParams of the given Product.
+func (s *ProductService) Edit(ctx context.Context, id int, body *ProductEditParams) (*Product, *Response, error) {
 	product := new(Product)
-	var apiError APIError
 
 	path := fmt.Sprintf("%v%v", productServicePath, id)
-	response, err := performGET(ctx, s.httpClient, s.config, path, nil, &product, &apiError)
+	response, err := performPUT(ctx, s.client, path, nil, body, product)
 
-	return product, response, relevantError(err, apiError)
+	return product, response, err
+}
+
+// Delete deletes the given Product.
+func (s *ProductService) Delete(ctx context.Context, id int) (*Response, error) {
+	path := fmt.Sprintf("%v%v", productServicePath, id)
+	return performDELETE(ctx, s.client, path, nil)
 }
@@ -3,8 +3,10 @@ package bigcommerce
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -58,6 +60,145 @@ func TestProductService_ListWithError(t *testing.T) {
 	assert.True(t, len(products) == 0)
 }
 
+func TestProductService_ListWithFilters(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{
+			"page":              "2",
+			"limit":             "50",
+			"keyword_filter":    "shirt",
+			"category":          "18",
+			"brand_id":          "4",
+			"min_price":         "9.99",
+			"max_price":         "49.5",
+			"min_date_modified": "Wed, 14 Nov 2012 19:26:23 +0000",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{ "id": 123 }]`)
+	})
+
+	expected := []Product{
+		{ID: 123},
+	}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	minDateModified := time.Date(2012, time.November, 14, 19, 26, 23, 0, time.UTC)
+	params := &ProductListParams{
+		Page:            2,
+		Limit:           50,
+		KeywordFilter:   "shirt",
+		Category:        18,
+		BrandID:         4,
+		MinPrice:        9.99,
+		MaxPrice:        49.5,
+		MinDateModified: minDateModified,
+	}
+	products, _, err := client.Products.List(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, products)
+}
+
+func TestProductService_ListAll(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 1 }, { "id": 2 }]`)
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{ "id": 3 }]`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	var products []Product
+	it := client.Products.ListAll(context.Background(), nil)
+	for it.Next() {
+		products = append(products, it.Value())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []Product{{ID: 1}, {ID: 2}, {ID: 3}}, products)
+}
+
+func TestProductService_Count(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "count": 12 }`)
+	})
+
+	expected := 12
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &ProductListParams{
+		Limit: 10,
+	}
+	count, _, err := client.Products.Count(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, count)
+}
+
+func TestProductService_CountWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/count", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"limit": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &ProductListParams{
+		Limit: 10,
+	}
+	_, _, err := client.Products.Count(context.Background(), params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductService_ListNoContent(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	products, _, err := client.Products.List(context.Background(), &ProductListParams{})
+	assert.Nil(t, err)
+	assert.True(t, len(products) == 0)
+}
+
 func TestProductService_Show(t *testing.T) {
 	httpClient, mux, server := testServer()
 	defer server.Close()
@@ -95,3 +236,235 @@ func TestProductService_ShowWithError(t *testing.T) {
 	_, _, err := client.Products.Show(context.Background(), 123)
 	assert.EqualError(t, err, "bigcommerce: 400 Bad Request")
 }
+
+func TestProductService_New(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &Product{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &ProductBody{
+		Name:         "Plain T-Shirt",
+		Type:         ProductTypePhysical,
+		Price:        29.99,
+		Weight:       0.5,
+		Categories:   []int{18},
+		Availability: ProductAvailabilityAvailable,
+		IsVisible:    true,
+	}
+	product, _, err := client.Products.New(context.Background(), body)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, product)
+}
+
+func TestProductService_NewWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &ProductBody{
+		Name:         "Plain T-Shirt",
+		Type:         ProductTypePhysical,
+		Price:        29.99,
+		Weight:       0.5,
+		Categories:   []int{18},
+		Availability: ProductAvailabilityAvailable,
+		IsVisible:    true,
+	}
+	_, _, err := client.Products.New(context.Background(), body)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductService_Edit(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	expected := &Product{ID: 123}
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &ProductEditParams{
+		Availability: ProductAvailabilityDisabled,
+	}
+	product, _, err := client.Products.Edit(context.Background(), 3, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, product)
+}
+
+func TestProductService_EditWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	params := &ProductEditParams{
+		Availability: ProductAvailabilityDisabled,
+	}
+	_, _, err := client.Products.Edit(context.Background(), 3, params)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductService_Delete(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	response, err := client.Products.Delete(context.Background(), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestProductService_DeleteWithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/3", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, err := client.Products.Delete(context.Background(), 3)
+	assert.EqualError(t, err, BadRequestErrorMessage)
+}
+
+func TestProductService_NewBody(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{
+  "name": "Plain T-Shirt",
+  "type": "physical",
+  "sku": "PLAIN-T",
+  "price": 29.99,
+  "weight": 0.5,
+  "categories": [18, 23],
+  "availability": "available",
+  "inventory_tracking": "simple",
+  "inventory_level": 12,
+  "is_visible": true
+}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "id": 33,
+  "name": "Plain T-Shirt",
+  "type": "physical",
+  "sku": "PLAIN-T",
+  "price": "29.9900",
+  "weight": "0.5000",
+  "categories": [18, 23],
+  "availability": "available",
+  "inventory_tracking": "simple",
+  "inventory_level": 12,
+  "is_visible": true,
+  "date_created": "Fri, 21 Sep 2012 02:31:01 +0000",
+  "date_modified": ""
+}`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	body := &ProductBody{
+		Name:              "Plain T-Shirt",
+		Type:              ProductTypePhysical,
+		Sku:               "PLAIN-T",
+		Price:             29.99,
+		Weight:            0.5,
+		Categories:        []int{18, 23},
+		Availability:      ProductAvailabilityAvailable,
+		InventoryTracking: InventoryTrackingSimple,
+		InventoryLevel:    12,
+		IsVisible:         true,
+	}
+	product, _, err := client.Products.New(context.Background(), body)
+	assert.Nil(t, err)
+	assert.Equal(t, 33, product.ID)
+	assert.Equal(t, ProductTypePhysical, product.Type)
+	assert.Equal(t, "29.9900", product.Price)
+	assert.Equal(t, []int{18, 23}, product.Categories)
+	assert.Equal(t, InventoryTrackingSimple, product.InventoryTracking)
+	assert.True(t, product.IsVisible)
+	assert.Equal(t, 2012, product.DateCreated.Time().Year())
+	assert.Nil(t, product.DateModified.Time())
+}
+
+func TestProductService_EditBody(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/products/33", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{ "price": 0, "is_visible": false }`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{ "id": 33 }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	price := 0.0
+	isVisible := false
+	params := &ProductEditParams{
+		Price:     &price,
+		IsVisible: &isVisible,
+	}
+	_, _, err := client.Products.Edit(context.Background(), 33, params)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,86 @@
+package bigcommerce
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimiter tracks the request quota reported by the X-Rate-Limit headers
+// and blocks requests while the quota is exhausted. A single rateLimiter is
+// shared by all services of a Client.
+type rateLimiter struct {
+	mu      sync.Mutex
+	known   bool
+	left    int
+	quota   int
+	window  time.Duration
+	resetAt time.Time
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{}
+}
+
+// wait blocks until the quota allows a request to be sent or the context is
+// done. The quota is reserved for the request before wait returns.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		if !l.known {
+			l.mu.Unlock()
+			return nil
+		}
+		now := time.Now()
+		if !now.Before(l.resetAt) {
+			if l.quota <= 0 || l.window <= 0 {
+				// The next window is unknown until a response reports it.
+				l.known = false
+				l.mu.Unlock()
+				return nil
+			}
+			l.left = l.quota
+			l.resetAt = now.Add(l.window)
+		}
+		if l.left > 0 {
+			l.left--
+			l.mu.Unlock()
+			return nil
+		}
+		timer := time.NewTimer(l.resetAt.Sub(now))
+		l.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// update records the quota reported by the X-Rate-Limit headers.
+// Headers without a remaining quota are ignored.
+func (l *rateLimiter) update(header http.Header) {
+	if header.Get("X-Rate-Limit-Requests-Left") == "" {
+		return
+	}
+	rateLimit := parseRateLimit(header)
+	reset := rateLimit.TimeReset
+	if header.Get("X-Rate-Limit-Time-Reset-Ms") == "" {
+		reset = rateLimit.TimeWindow
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if reset <= 0 {
+		// Without a reset time the quota cannot be tracked.
+		l.known = false
+		return
+	}
+	l.known = true
+	l.left = rateLimit.RequestsLeft
+	l.quota = rateLimit.RequestsQuota
+	l.window = rateLimit.TimeWindow
+	l.resetAt = time.Now().Add(reset)
+}
@@ -0,0 +1,88 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rateLimitHeader(left, quota, windowMs, resetMs string) http.Header {
+	header := http.Header{}
+	header.Set("X-Rate-Limit-Requests-Left", left)
+	header.Set("X-Rate-Limit-Requests-Quota", quota)
+	header.Set("X-Rate-Limit-Time-Window-Ms", windowMs)
+	header.Set("X-Rate-Limit-Time-Reset-Ms", resetMs)
+	return header
+}
+
+func TestRateLimiter_WaitWithoutQuota(t *testing.T) {
+	limiter := newRateLimiter()
+	assert.Nil(t, limiter.wait(context.Background()))
+
+	limiter.update(http.Header{})
+	assert.Nil(t, limiter.wait(context.Background()))
+}
+
+func TestRateLimiter_WaitWithQuotaLeft(t *testing.T) {
+	limiter := newRateLimiter()
+	limiter.update(rateLimitHeader("2", "10", "30000", "30000"))
+
+	start := time.Now()
+	assert.Nil(t, limiter.wait(context.Background()))
+	assert.Nil(t, limiter.wait(context.Background()))
+	assert.True(t, time.Since(start) < 10*time.Millisecond)
+	assert.Equal(t, 0, limiter.left)
+}
+
+func TestRateLimiter_WaitUntilReset(t *testing.T) {
+	limiter := newRateLimiter()
+	limiter.update(rateLimitHeader("0", "10", "30000", "20"))
+
+	start := time.Now()
+	assert.Nil(t, limiter.wait(context.Background()))
+	assert.True(t, time.Since(start) >= 20*time.Millisecond)
+	// The quota of the next window is reserved for the request.
+	assert.Equal(t, 9, limiter.left)
+}
+
+func TestRateLimiter_WaitContextCanceled(t *testing.T) {
+	limiter := newRateLimiter()
+	limiter.update(rateLimitHeader("0", "10", "30000", "30000"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, limiter.wait(ctx))
+}
+
+func TestRateLimiter_SharedByServices(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/count", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Rate-Limit-Requests-Left", "0")
+		w.Header().Set("X-Rate-Limit-Requests-Quota", "10")
+		w.Header().Set("X-Rate-Limit-Time-Window-Ms", "30000")
+		w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "30000")
+		fmt.Fprint(w, `{ "count": 12 }`)
+	})
+	mux.HandleFunc("/api/v2/order_statuses/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected request to be blocked by the rate limiter")
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, _, err := client.Orders.Count(context.Background(), &OrderListParams{})
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err = client.OrderStatuses.List(ctx, &OrderStatusListParams{})
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
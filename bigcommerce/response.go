@@ -0,0 +1,54 @@
+package bigcommerce
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Response wraps the *http.Response of an API request and exposes the
+// metadata of the response. The Body of the response is already closed.
+type Response struct {
+	*http.Response
+	// RateLimit is the request quota reported by the X-Rate-Limit headers.
+	RateLimit RateLimit
+	// Pagination is the pagination of V3 list responses. It is nil for V2
+	// responses.
+	Pagination *Pagination
+	// RequestID is the ID assigned to the request by Bigcommerce, if any.
+	RequestID string
+}
+
+// RateLimit describes the request quota reported by the X-Rate-Limit headers.
+type RateLimit struct {
+	// RequestsLeft is the number of requests left in the current window.
+	RequestsLeft int
+	// RequestsQuota is the number of requests allowed per window.
+	RequestsQuota int
+	// TimeWindow is the duration of a window.
+	TimeWindow time.Duration
+	// TimeReset is the time left until the current window resets.
+	TimeReset time.Duration
+}
+
+func newResponse(r *http.Response) *Response {
+	return &Response{
+		Response:  r,
+		RateLimit: parseRateLimit(r.Header),
+		RequestID: r.Header.Get("X-Request-Id"),
+	}
+}
+
+// parseRateLimit parses the X-Rate-Limit headers. Missing headers are zero.
+func parseRateLimit(header http.Header) RateLimit {
+	left, _ := strconv.Atoi(header.Get("X-Rate-Limit-Requests-Left"))
+	quota, _ := strconv.Atoi(header.Get("X-Rate-Limit-Requests-Quota"))
+	windowMs, _ := strconv.Atoi(header.Get("X-Rate-Limit-Time-Window-Ms"))
+	resetMs, _ := strconv.Atoi(header.Get("X-Rate-Limit-Time-Reset-Ms"))
+	return RateLimit{
+		RequestsLeft:  left,
+		RequestsQuota: quota,
+		TimeWindow:    time.Duration(windowMs) * time.Millisecond,
+		TimeReset:     time.Duration(resetMs) * time.Millisecond,
+	}
+}
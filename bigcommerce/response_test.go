@@ -0,0 +1,63 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponse_Metadata(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/count", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "f4b3c2a1")
+		w.Header().Set("X-Rate-Limit-Requests-Left", "149")
+		w.Header().Set("X-Rate-Limit-Requests-Quota", "150")
+		w.Header().Set("X-Rate-Limit-Time-Window-Ms", "30000")
+		w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "29500")
+		fmt.Fprint(w, `{ "count": 12 }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, response, err := client.Orders.Count(context.Background(), &OrderListParams{})
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "f4b3c2a1", response.RequestID)
+	assert.Equal(t, RateLimit{
+		RequestsLeft:  149,
+		RequestsQuota: 150,
+		TimeWindow:    30 * time.Second,
+		TimeReset:     29500 * time.Millisecond,
+	}, response.RateLimit)
+	assert.Nil(t, response.Pagination)
+}
+
+func TestResponse_WithError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/orders/count", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "f4b3c2a1")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, BadRequestJSON)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	_, response, err := client.Orders.Count(context.Background(), &OrderListParams{})
+	assert.EqualError(t, err, BadRequestErrorMessage)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	assert.Equal(t, "f4b3c2a1", response.RequestID)
+}
@@ -0,0 +1,122 @@
+package bigcommerce
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how requests failing with 429 Too Many Requests,
+// a transient 5xx status or a network error are retried.
+// GET, PUT and DELETE requests are retried. POST requests are only retried when
+// RetryPOST is enabled since they are not idempotent.
+//
+// A 429 response waits as long as its X-Rate-Limit-Time-Reset-Ms or Retry-After
+// header requests. A 5xx response waits as long as its Retry-After header requests
+// and backs off exponentially otherwise, since BigCommerce sends the X-Rate-Limit
+// headers on every response. Waits requested by the server are capped at MaxBackoff
+// as well; the rate limiter of the client still holds back further requests until
+// an exhausted quota resets.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff is the backoff before the first retry. It doubles on every
+	// following retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the backoff between two attempts.
+	MaxBackoff time.Duration
+	// RetryPOST enables retries of POST requests.
+	RetryPOST bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy making up to 3 attempts with
+// backoffs between 500ms and 30s.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+	}
+}
+
+// retryable returns true if the given method may be retried.
+func (p *RetryPolicy) retryable(method string) bool {
+	switch method {
+	case methodGET, methodPUT, methodDELETE:
+		return true
+	case methodPOST:
+		return p.RetryPOST
+	}
+	return false
+}
+
+// shouldRetry returns true if the outcome of the given attempt should be retried.
+func (p *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, err error, attempt int) bool {
+	if p == nil || attempt >= p.MaxAttempts || !p.retryable(req.Method) {
+		return false
+	}
+	if err != nil {
+		return req.Context().Err() == nil
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt. The wait
+// requested by the server is honored up to MaxBackoff, see RetryPolicy.
+// Otherwise, an exponential backoff with jitter is used.
+func (p *RetryPolicy) backoff(resp *http.Response, attempt int) time.Duration {
+	if wait, ok := requestedWait(resp); ok {
+		if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+			return p.MaxBackoff
+		}
+		return wait
+	}
+	backoff := p.MinBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Equal jitter: wait at least half of the backoff.
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(backoff-half)+1))
+}
+
+// requestedWait returns the wait requested by the server. The
+// X-Rate-Limit-Time-Reset-Ms header is only used for 429 responses, since it is
+// sent with every response. The Retry-After header is used for all statuses.
+func requestedWait(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if ms, err := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Time-Reset-Ms")); err == nil && ms >= 0 {
+			return time.Duration(ms) * time.Millisecond, true
+		}
+	}
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if t, err := http.ParseTime(retryAfter); err == nil {
+			if wait := time.Until(t); wait > 0 {
+				return wait, true
+			}
+			return 0, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,261 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+	}
+}
+
+func TestRetryPolicy_RetriesGET(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/order_statuses/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `[{ "status": 503, "message": "Service Unavailable" }]`)
+			return
+		}
+		fmt.Fprint(w, `[{ "id": 1 }]`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	client.RetryPolicy = testRetryPolicy()
+	orderStatuses, _, err := client.OrderStatuses.List(context.Background(), &OrderStatusListParams{})
+	assert.Nil(t, err)
+	assert.Equal(t, []OrderStatus{{ID: 1}}, orderStatuses)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryPolicy_ReplaysBody(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/orders/123", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"status_id":1}`, string(body))
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if attempts == 1 {
+			w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `[{ "status": 429, "message": "Too Many Requests" }]`)
+			return
+		}
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	client.RetryPolicy = testRetryPolicy()
+	statusID := 1
+	order, _, err := client.Orders.Edit(context.Background(), 123, &OrderEditParams{StatusID: &statusID})
+	assert.Nil(t, err)
+	assert.Equal(t, &Order{ID: 123}, order)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryPolicy_GivesUpAfterMaxAttempts(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/order_statuses/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, `[{ "status": 502, "message": "Bad Gateway" }]`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	client.RetryPolicy = testRetryPolicy()
+	_, _, err := client.OrderStatuses.List(context.Background(), &OrderStatusListParams{})
+	assert.EqualError(t, err, "bigcommerce: 502 Bad Gateway")
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryPolicy_SkipsPOST(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/orders/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `[{ "status": 503, "message": "Service Unavailable" }]`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	client.RetryPolicy = testRetryPolicy()
+	_, _, err := client.Orders.New(context.Background(), &OrderBody{})
+	assert.EqualError(t, err, "bigcommerce: 503 Service Unavailable")
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryPolicy_RetriesPOST(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/orders/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `[{ "status": 503, "message": "Service Unavailable" }]`)
+			return
+		}
+		fmt.Fprint(w, `{ "id": 123 }`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	client.RetryPolicy = testRetryPolicy()
+	client.RetryPolicy.RetryPOST = true
+	order, _, err := client.Orders.New(context.Background(), &OrderBody{})
+	assert.Nil(t, err)
+	assert.Equal(t, &Order{ID: 123}, order)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryPolicy_ContextCanceled(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v2/order_statuses/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `[{ "status": 429, "message": "Too Many Requests" }]`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	client.RetryPolicy = testRetryPolicy()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err := client.OrderStatuses.List(ctx, &OrderStatusListParams{})
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{
+		MaxAttempts: 5,
+		MinBackoff:  100 * time.Millisecond,
+		MaxBackoff:  300 * time.Millisecond,
+	}
+	for attempt, max := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 300 * time.Millisecond,
+		4: 300 * time.Millisecond,
+	} {
+		backoff := policy.backoff(nil, attempt)
+		assert.True(t, backoff >= max/2, "attempt %d backoff %v below %v", attempt, backoff, max/2)
+		assert.True(t, backoff <= max, "attempt %d backoff %v above %v", attempt, backoff, max)
+	}
+}
+
+func TestRetryPolicy_BackoffRequestedWait(t *testing.T) {
+	policy := &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  100 * time.Millisecond,
+		MaxBackoff:  time.Second,
+	}
+	newResponse := func(status int, header map[string]string) *http.Response {
+		resp := &http.Response{StatusCode: status, Header: http.Header{}}
+		for key, value := range header {
+			resp.Header.Set(key, value)
+		}
+		return resp
+	}
+
+	resp := newResponse(http.StatusTooManyRequests, map[string]string{"X-Rate-Limit-Time-Reset-Ms": "250"})
+	assert.Equal(t, 250*time.Millisecond, policy.backoff(resp, 1))
+
+	resp = newResponse(http.StatusTooManyRequests, map[string]string{"X-Rate-Limit-Time-Reset-Ms": "29000"})
+	assert.Equal(t, time.Second, policy.backoff(resp, 1))
+
+	resp = newResponse(http.StatusServiceUnavailable, map[string]string{"Retry-After": "0"})
+	assert.Equal(t, time.Duration(0), policy.backoff(resp, 1))
+
+	resp = newResponse(http.StatusServiceUnavailable, map[string]string{
+		"X-Rate-Limit-Requests-Left": "149",
+		"X-Rate-Limit-Time-Reset-Ms": "29000",
+	})
+	backoff := policy.backoff(resp, 1)
+	assert.True(t, backoff >= 50*time.Millisecond && backoff <= 100*time.Millisecond, "backoff %v", backoff)
+}
+
+func TestRetryPolicy_Retries5xxWithRateLimitHeaders(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/order_statuses/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Rate-Limit-Requests-Left", "149")
+		w.Header().Set("X-Rate-Limit-Requests-Quota", "150")
+		w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "29000")
+		w.Header().Set("X-Rate-Limit-Time-Window-Ms", "30000")
+		if attempts < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			fmt.Fprint(w, `[{ "status": 502, "message": "Bad Gateway" }]`)
+			return
+		}
+		fmt.Fprint(w, `[{ "id": 1 }]`)
+	})
+
+	client := NewClient(httpClient, &ClientConfig{
+		Endpoint: "https://example.com",
+		UserName: "go-bigcommerce",
+		Password: "12345"})
+	client.RetryPolicy = testRetryPolicy()
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTestTimeout)
+	defer cancel()
+	orderStatuses, _, err := client.OrderStatuses.List(ctx, &OrderStatusListParams{})
+	assert.Nil(t, err)
+	assert.Equal(t, []OrderStatus{{ID: 1}}, orderStatuses)
+	assert.Equal(t, 3, attempts)
+}